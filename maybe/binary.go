@@ -0,0 +1,77 @@
+package maybe
+
+import (
+	"bytes"
+	"encoding"
+	"encoding/gob"
+	"errors"
+	"fmt"
+)
+
+// Binary form of an Option: a presence byte, followed by the encoded value for Some.
+const (
+	binaryNone byte = 0
+	binarySome byte = 1
+)
+
+// MarshalBinary implements the encoding.BinaryMarshaler interface.
+// None is encoded as a single zero byte; Some(v) as a one byte followed by v,
+// encoded via encoding.BinaryMarshaler if T implements it, or via encoding/gob otherwise.
+func (o Option[T]) MarshalBinary() ([]byte, error) {
+	if !o.ok {
+		return []byte{binaryNone}, nil
+	}
+
+	if bm, ok := any(o.value).(encoding.BinaryMarshaler); ok {
+		data, err := bm.MarshalBinary()
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{binarySome}, data...), nil
+	}
+
+	buf := bytes.NewBuffer([]byte{binarySome})
+	if err := gob.NewEncoder(buf).Encode(&o.value); err != nil {
+		return nil, err
+	}
+	return buf.Bytes(), nil
+}
+
+// UnmarshalBinary implements the encoding.BinaryUnmarshaler interface.
+// It decodes data produced by MarshalBinary.
+func (o *Option[T]) UnmarshalBinary(data []byte) error {
+	if len(data) == 0 {
+		return errors.New("empty binary data for Option")
+	}
+
+	switch data[0] {
+	case binaryNone:
+		*o = None[T]()
+		return nil
+	case binarySome:
+	default:
+		return fmt.Errorf("invalid binary Option presence byte %d", data[0])
+	}
+
+	var v T
+	if bu, ok := any(&v).(encoding.BinaryUnmarshaler); ok {
+		if err := bu.UnmarshalBinary(data[1:]); err != nil {
+			return err
+		}
+	} else if err := gob.NewDecoder(bytes.NewReader(data[1:])).Decode(&v); err != nil {
+		return err
+	}
+
+	*o = Some(v)
+	return nil
+}
+
+// GobEncode implements the gob.GobEncoder interface (see MarshalBinary).
+func (o Option[T]) GobEncode() ([]byte, error) {
+	return o.MarshalBinary()
+}
+
+// GobDecode implements the gob.GobDecoder interface (see UnmarshalBinary).
+func (o *Option[T]) GobDecode(data []byte) error {
+	return o.UnmarshalBinary(data)
+}
@@ -0,0 +1,43 @@
+package maybe
+
+import "cmp"
+
+// Equal reports whether both Options are None, or both are Some with equal values.
+// It also makes Options comparable with github.com/google/go-cmp, which otherwise panics on unexported fields.
+func (o Option[T]) Equal(other Option[T]) bool {
+	if o.ok != other.ok {
+		return false
+	}
+	return !o.ok || o.value == other.value
+}
+
+// Compare returns -1, 0 or +1 depending on whether a is less than, equal to or greater than b.
+// None is ordered before any Some; Some values are compared via cmp.Compare.
+// It can be used with slices.SortFunc directly.
+func Compare[T cmp.Ordered](a, b Option[T]) int {
+	return compare(a, b, -1)
+}
+
+// CompareNoneLast is like Compare, but None is ordered after any Some.
+func CompareNoneLast[T cmp.Ordered](a, b Option[T]) int {
+	return compare(a, b, +1)
+}
+
+// Less reports whether a is less than b, following Compare (None first).
+func Less[T cmp.Ordered](a, b Option[T]) bool {
+	return Compare(a, b) < 0
+}
+
+// compare compares a and b, ordering None as noneOrder relative to any Some.
+func compare[T cmp.Ordered](a, b Option[T], noneOrder int) int {
+	switch {
+	case !a.ok && !b.ok:
+		return 0
+	case !a.ok:
+		return noneOrder
+	case !b.ok:
+		return -noneOrder
+	default:
+		return cmp.Compare(a.value, b.value)
+	}
+}
@@ -5,14 +5,40 @@
 //
 //   - Construction via Some(v) and None[T]().
 //   - Querying presence with Some() and None() methods.
-//   - Unwrapping with Unwrap(), which panics on None.
+//   - Unwrapping with Unwrap(), which panics on None, or UnwrapOr(def).
+//   - Mutating in place with Insert(), Clear(), Take(), Replace(), GetOrInsert() and GetOrInsertWith().
 //   - JSON marshalling: encodes None as null, Some(v) as v.
-//   - TOML marshalling: encodes None as the special TomlNone hack.
+//   - TOML marshalling: encodes None as the special TomlNone hack; UnmarshalTOML decodes it back
+//     and converts native TOML values into T.
+//   - Binary and gob encoding: a presence byte followed by the value (via encoding.BinaryMarshaler or gob).
+//   - YAML marshalling (v2 and v3, no dependency): encodes None as null; null, ~ and missing keys
+//     decode as None. UnmarshalYAMLNode is a shim for YAML v3 node-based unmarshalers.
+//   - Text marshalling: encodes None as empty text, Some(v) via encoding.TextMarshaler, strconv or fmt.
 //   - Text unmarshalling: treats empty, “null”, or TomlNone (case-insensitive) as None,
 //     otherwise attempts to parse into T (using encoding.TextUnmarshaler if available,
-//     or a JSON fallback for scalars).
+//     time.ParseDuration for time.Duration, or strconv for any scalar kind, custom types included).
+//   - Flags: *Option[T] implements flag.Value (and pflag's Type); FlagVar binds it to a flag.FlagSet
+//     via FlagValue, which shows the plain text form (also for pflag); a flag that is not passed stays None.
+//   - Formatting: String renders Some(v) or None, GoString and %#v render Go syntax,
+//     and LogValue makes slog log the contained value (or null).
+//   - Comparison: Equal (also used by go-cmp), and Compare, CompareNoneLast and Less for ordered types.
+//   - Iterators: All yields zero or one value; Collect, Values and First work on iter.Seq of Options.
+//   - Zero checks: IsZero reports None (so `omitzero` drops only None fields, never Some(false)),
+//     IsNoneOrZero treats Some(zero) as empty too.
 //
-// Common helpers include True(), False(), and NoneBool() for boolean Optionals.
+// FromWrapper and ToWrapper convert Options to and from `{ Value T }`-shaped wrapper structs,
+// such as protobuf's wrapperspb.Int64Value (without depending on protobuf).
+//
+// Merge and MergeLayers merge structs of Options (e.g. defaults → file → env → flags), filling None fields
+// (or overriding with Some fields via the Override() option) and reporting which layer set each field.
+//
+// Lazy[T] is an Option computed once on first access, safe for concurrent readers.
+//
+// Field[T] is a three-state sibling of Option for PATCH-like payloads: Absent (key missing),
+// Null (explicit null) or Value. ApplyPatch applies a struct of Fields onto a target struct.
+//
+// Common helpers include True(), False(), and NoneBool() for boolean Optionals, and typed shorthands
+// Int, Int64, Uint, Float, String, Duration and Time with their NoneX() constructors.
 //
 // Usage:
 //
@@ -0,0 +1,228 @@
+package maybe
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// fieldState is the state of a Field.
+type fieldState uint8
+
+const (
+	fieldAbsent fieldState = iota
+	fieldNull
+	fieldValue
+)
+
+// Field is a three-state optional value, designed for PATCH-like payloads
+// where "key is absent" (don't touch) differs from "key is null" (clear it).
+// The zero value of Field is Absent.
+//
+// In JSON, a missing key leaves the Field Absent, `null` makes it Null and anything else is a Value.
+// Marshal it with the `omitzero` tag option so Absent fields are omitted.
+type Field[T comparable] struct {
+	value T
+	state fieldState
+}
+
+// Absent constructs a Field that was not specified at all.
+func Absent[T comparable]() Field[T] {
+	return Field[T]{state: fieldAbsent}
+}
+
+// Null constructs a Field that was explicitly set to null.
+func Null[T comparable]() Field[T] {
+	return Field[T]{state: fieldNull}
+}
+
+// Value constructs a Field that contains a value.
+func Value[T comparable](v T) Field[T] {
+	return Field[T]{value: v, state: fieldValue}
+}
+
+// IsAbsent returns true if the Field was not specified.
+func (f *Field[T]) IsAbsent() bool {
+	return f.state == fieldAbsent
+}
+
+// IsNull returns true if the Field was explicitly set to null.
+func (f *Field[T]) IsNull() bool {
+	return f.state == fieldNull
+}
+
+// IsValue returns true if the Field contains a value.
+func (f *Field[T]) IsValue() bool {
+	return f.state == fieldValue
+}
+
+// Unwrap returns the contained value if present; otherwise, it panics.
+func (f *Field[T]) Unwrap() T {
+	if f.state != fieldValue {
+		panic("called Unwrap on a Field without a value")
+	}
+	return f.value
+}
+
+// Option converts the Field into an Option: Some for a Value, None for both Absent and Null.
+func (f *Field[T]) Option() Option[T] {
+	if f.state != fieldValue {
+		return None[T]()
+	}
+	return Some(f.value)
+}
+
+// IsZero returns true if the Field is Absent (for `omitzero` interface).
+func (f *Field[T]) IsZero() bool {
+	return f.IsAbsent()
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// Null marshals to JSON null, Value marshals to the contained value.
+// Absent marshals to null as well: use `omitzero` to omit it.
+func (f Field[T]) MarshalJSON() ([]byte, error) {
+	if f.state != fieldValue {
+		return []byte("null"), nil
+	}
+	return json.Marshal(f.value)
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It is only called for keys that are present: JSON null makes the Field Null, anything else a Value.
+func (f *Field[T]) UnmarshalJSON(data []byte) error {
+	if string(data) == "null" {
+		*f = Null[T]()
+		return nil
+	}
+
+	var v T
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+	*f = Value(v)
+	return nil
+}
+
+// applyTo applies the Field onto dst, which is a settable value of type T, *T, Option[T] or Field[T].
+func (f *Field[T]) applyTo(dst reflect.Value) error {
+	if f.state == fieldAbsent {
+		return nil
+	}
+
+	switch d := dst.Addr().Interface().(type) {
+	case *T:
+		*d = f.value // zero value for Null
+	case **T:
+		if f.state == fieldNull {
+			*d = nil
+		} else {
+			v := f.value
+			*d = &v
+		}
+	case *Option[T]:
+		*d = f.Option()
+	case *Field[T]:
+		*d = *f
+	default:
+		return fmt.Errorf("cannot apply %T onto %s", *f, dst.Type())
+	}
+	return nil
+}
+
+// fieldPatcher is implemented by every Field[T].
+type fieldPatcher interface {
+	IsAbsent() bool
+	IsValue() bool
+	applyTo(dst reflect.Value) error
+}
+
+// patchTarget returns the settable field of the struct dv with the given name, including promoted fields.
+// Nil embedded pointers on the way are allocated if alloc is true (as encoding/json does);
+// otherwise, the invalid reflect.Value is returned for them.
+func patchTarget(dv reflect.Value, name string, alloc bool) (reflect.Value, error) {
+	sf, ok := dv.Type().FieldByName(name)
+	if !ok {
+		return reflect.Value{}, fmt.Errorf("no such settable field in %s", dv.Type())
+	}
+
+	v := dv
+	for i, idx := range sf.Index {
+		if i > 0 && v.Kind() == reflect.Pointer {
+			if v.IsNil() {
+				if !alloc {
+					return reflect.Value{}, nil
+				}
+				if !v.CanSet() {
+					return reflect.Value{}, fmt.Errorf("cannot allocate unexported embedded %s", v.Type())
+				}
+				v.Set(reflect.New(v.Type().Elem()))
+			}
+			v = v.Elem()
+		}
+		v = v.Field(idx)
+	}
+
+	if !v.CanSet() {
+		return reflect.Value{}, fmt.Errorf("no such settable field in %s", dv.Type())
+	}
+	return v, nil
+}
+
+// ApplyPatch applies a patch struct (or pointer to it) onto the struct pointed to by dst.
+// Every exported Field of the patch is applied onto the dst field with the same name:
+//   - Absent fields are skipped;
+//   - Null fields reset the dst field (zero value for T, nil for *T, None for Option[T]);
+//   - Value fields are set (T, *T, Option[T] or Field[T] dst fields are supported).
+//
+// Promoted dst fields are supported too: nil embedded pointers are allocated to set a Value.
+// Other patch fields are ignored.
+func ApplyPatch(dst, patch any) error {
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Pointer || dv.IsNil() || dv.Elem().Kind() != reflect.Struct {
+		return fmt.Errorf("dst must be a non-nil pointer to a struct, got %T", dst)
+	}
+	dv = dv.Elem()
+
+	pv := reflect.Indirect(reflect.ValueOf(patch))
+	if pv.Kind() != reflect.Struct {
+		return fmt.Errorf("patch must be a struct or a pointer to a struct, got %T", patch)
+	}
+
+	if !pv.CanAddr() {
+		// Field methods have pointer receivers, so work on an addressable copy
+		cp := reflect.New(pv.Type()).Elem()
+		cp.Set(pv)
+		pv = cp
+	}
+
+	var errs []error
+	pt := pv.Type()
+	for i := range pt.NumField() {
+		sf := pt.Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+		fp, ok := pv.Field(i).Addr().Interface().(fieldPatcher)
+		if !ok {
+			continue
+		}
+
+		if fp.IsAbsent() {
+			continue
+		}
+
+		df, err := patchTarget(dv, sf.Name, fp.IsValue())
+		if err != nil {
+			errs = append(errs, fmt.Errorf("field %s: %w", sf.Name, err))
+			continue
+		}
+		if !df.IsValid() {
+			continue // Null through a nil embedded pointer: nothing to reset
+		}
+		if err := fp.applyTo(df); err != nil {
+			errs = append(errs, fmt.Errorf("field %s: %w", sf.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,115 @@
+package maybe
+
+import (
+	"encoding"
+	"flag"
+	"fmt"
+	"reflect"
+	"time"
+)
+
+// Set implements the flag.Value interface.
+// A flag that was passed always has a value, so Set never produces None:
+// strings are taken as is (so `-name=""` is Some("")), other types are parsed as in UnmarshalText.
+func (o *Option[T]) Set(s string) error {
+	var v T
+	if rv := reflect.ValueOf(&v).Elem(); rv.Kind() == reflect.String {
+		if _, ok := any(&v).(encoding.TextUnmarshaler); !ok {
+			rv.SetString(s)
+			*o = Some(v)
+			return nil
+		}
+	}
+
+	v, err := parseText[T]([]byte(s))
+	if err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// IsBoolFlag reports whether T is a boolean kind, so a bool Option flag can be passed as plain `-flag`.
+// It makes Option implement flag's (unexported) boolFlag interface.
+func (o *Option[T]) IsBoolFlag() bool {
+	return reflect.TypeFor[T]().Kind() == reflect.Bool
+}
+
+// Type returns the type name of the flag value, as expected by the pflag.Value interface.
+// Scalars are named by their kind ("int", "bool", "string", etc.), time.Duration is "duration".
+func (o *Option[T]) Type() string {
+	t := reflect.TypeFor[T]()
+	if t == reflect.TypeFor[time.Duration]() {
+		return "duration"
+	}
+
+	switch t.Kind() {
+	case reflect.String, reflect.Bool,
+		reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64,
+		reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr,
+		reflect.Float32, reflect.Float64:
+		return t.Kind().String()
+	default:
+		return t.String()
+	}
+}
+
+// FlagValue adapts an Option for flag sets: its String is the text form of the value
+// (e.g. "8080" rather than Option's "Some(8080)"), which Set accepts back.
+// So usage messages show plain defaults, and pflag's typed getters (GetInt, GetDuration, etc.) can parse it.
+// FlagVar registers Options through it; for pflag, use fs.Var(maybe.NewFlagValue(&o), name, usage).
+type FlagValue[T comparable] struct {
+	o *Option[T]
+}
+
+// NewFlagValue returns a flag value that stores what the flag is set to in o.
+func NewFlagValue[T comparable](o *Option[T]) *FlagValue[T] {
+	return &FlagValue[T]{o: o}
+}
+
+// Set implements the flag.Value interface, see Option.Set.
+func (v *FlagValue[T]) Set(s string) error {
+	return v.o.Set(s)
+}
+
+// String implements the flag.Value interface.
+// It returns the text form of the value: the string as is for string kinds, MarshalText otherwise,
+// and empty text for None (or for the zero FlagValue the flag package creates to detect defaults).
+func (v *FlagValue[T]) String() string {
+	if v == nil || v.o == nil || !v.o.ok {
+		return ""
+	}
+
+	if rv := reflect.ValueOf(v.o.value); rv.Kind() == reflect.String {
+		if _, ok := any(v.o.value).(encoding.TextMarshaler); !ok {
+			return rv.String()
+		}
+	}
+
+	text, err := v.o.MarshalText()
+	if err != nil {
+		return fmt.Sprint(v.o.value)
+	}
+	return string(text)
+}
+
+// IsBoolFlag implements flag's (unexported) boolFlag interface, see Option.IsBoolFlag.
+func (v *FlagValue[T]) IsBoolFlag() bool {
+	return v.o.IsBoolFlag()
+}
+
+// Type implements the pflag.Value interface, see Option.Type.
+func (v *FlagValue[T]) Type() string {
+	return v.o.Type()
+}
+
+// FlagVar defines a flag with the specified name and usage string on the given flag set
+// (flag.CommandLine if fs is nil). The value of the flag is stored in o:
+// it stays as it is (e.g. None) unless the flag is passed.
+// The flag is registered as a FlagValue, so a Some default is shown by its text form (e.g. "default 8080").
+func FlagVar[T comparable](fs *flag.FlagSet, o *Option[T], name, usage string) {
+	if fs == nil {
+		fs = flag.CommandLine
+	}
+	fs.Var(NewFlagValue(o), name, usage)
+}
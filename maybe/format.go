@@ -0,0 +1,58 @@
+package maybe
+
+import (
+	"fmt"
+	"io"
+	"log/slog"
+	"reflect"
+)
+
+// String implements the fmt.Stringer interface (and completes the flag.Value interface of *Option).
+// It renders the Option as Some(v) or None; see FlagValue for the plain text form flags need.
+func (o Option[T]) String() string {
+	if !o.ok {
+		return "None"
+	}
+	return fmt.Sprintf("Some(%v)", o.value)
+}
+
+// GoString implements the fmt.GoStringer interface.
+// It renders the Option as Go syntax, e.g. maybe.Some[int](42) or maybe.None[int]().
+func (o Option[T]) GoString() string {
+	typ := reflect.TypeFor[T]().String()
+	if !o.ok {
+		return "maybe.None[" + typ + "]()"
+	}
+	return fmt.Sprintf("maybe.Some[%s](%#v)", typ, o.value)
+}
+
+// Format implements the fmt.Formatter interface:
+//   - %#v renders GoString;
+//   - None is always rendered as None, padded to the width if one is given;
+//   - for any other verb, Some(v) is rendered as Some(...) with v formatted by the same verb and flags
+//     (so %v gives Some(42), %+v gives Some({Name:x}), %q gives Some("x"), etc.).
+func (o Option[T]) Format(f fmt.State, verb rune) {
+	if verb == 'v' && f.Flag('#') {
+		_, _ = io.WriteString(f, o.GoString())
+		return
+	}
+	if !o.ok {
+		// only the width applies: the verb, the precision and other flags are meant for the value
+		width, _ := f.Width()
+		if f.Flag('-') {
+			width = -width
+		}
+		_, _ = fmt.Fprintf(f, "%*s", width, "None")
+		return
+	}
+	_, _ = fmt.Fprintf(f, "Some("+fmt.FormatString(f, verb)+")", o.value)
+}
+
+// LogValue implements the slog.LogValuer interface.
+// It logs the contained value for Some, or nil (null in JSON) for None.
+func (o Option[T]) LogValue() slog.Value {
+	if !o.ok {
+		return slog.AnyValue(nil)
+	}
+	return slog.AnyValue(o.value)
+}
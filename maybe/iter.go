@@ -0,0 +1,48 @@
+package maybe
+
+import "iter"
+
+// All returns an iterator that yields the contained value once for Some, and nothing for None.
+func (o Option[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		if o.ok {
+			yield(o.value)
+		}
+	}
+}
+
+// Collect collects the values of all Options in seq into a slice.
+// It returns false (and a nil slice) if any of the Options is None.
+//
+// Note: it can't return Option[[]T], as slices are not comparable.
+func Collect[T comparable](seq iter.Seq[Option[T]]) ([]T, bool) {
+	res := []T{}
+	for o := range seq {
+		if !o.ok {
+			return nil, false
+		}
+		res = append(res, o.value)
+	}
+	return res, true
+}
+
+// Values returns an iterator over the values of Some Options in seq, skipping Nones.
+func Values[T comparable](seq iter.Seq[Option[T]]) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for o := range seq {
+			if o.ok && !yield(o.value) {
+				return
+			}
+		}
+	}
+}
+
+// First returns the first Some Option in seq, or None if there is none.
+func First[T comparable](seq iter.Seq[Option[T]]) Option[T] {
+	for o := range seq {
+		if o.ok {
+			return o
+		}
+	}
+	return None[T]()
+}
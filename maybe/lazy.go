@@ -0,0 +1,69 @@
+package maybe
+
+import (
+	"encoding/json"
+	"sync"
+)
+
+// Lazy is an Option computed on first access, e.g. reading an env override.
+// The function is evaluated at most once (see sync.OnceValue), and Lazy is safe for concurrent use.
+// If the function panics, every access panics with the same value.
+//
+// The zero value of Lazy (as well as a nil *Lazy) is None.
+type Lazy[T comparable] struct {
+	get func() Option[T]
+}
+
+// NewLazy returns a Lazy that evaluates f on first access.
+func NewLazy[T comparable](f func() Option[T]) *Lazy[T] {
+	return &Lazy[T]{get: sync.OnceValue(f)}
+}
+
+// Get evaluates the Lazy (once) and returns the resulting Option.
+func (l *Lazy[T]) Get() Option[T] {
+	if l == nil || l.get == nil {
+		return None[T]()
+	}
+	return l.get()
+}
+
+// None returns true if the evaluated Option does not contain a valid value.
+func (l *Lazy[T]) None() bool {
+	o := l.Get()
+	return o.None()
+}
+
+// Some works as Option.Some on the evaluated Option.
+func (l *Lazy[T]) Some(args ...T) bool {
+	o := l.Get()
+	return o.Some(args...)
+}
+
+// Unwrap returns the evaluated value if present; otherwise, it panics.
+func (l *Lazy[T]) Unwrap() T {
+	o := l.Get()
+	return o.Unwrap()
+}
+
+// UnwrapOr returns the evaluated value if present; otherwise, it returns the given default.
+func (l *Lazy[T]) UnwrapOr(def T) T {
+	o := l.Get()
+	return o.UnwrapOr(def)
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// It evaluates the Lazy and marshals the result as an Option.
+func (l Lazy[T]) MarshalJSON() ([]byte, error) {
+	return json.Marshal(l.Get())
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// The decoded Option replaces the function: the Lazy becomes already evaluated.
+func (l *Lazy[T]) UnmarshalJSON(data []byte) error {
+	var o Option[T]
+	if err := json.Unmarshal(data, &o); err != nil {
+		return err
+	}
+	l.get = func() Option[T] { return o }
+	return nil
+}
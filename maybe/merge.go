@@ -0,0 +1,176 @@
+package maybe
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+)
+
+// optionValue is implemented by every Option[T]; it lets reflection-based helpers recognise Options.
+type optionValue interface {
+	isSome() bool
+}
+
+// optionValueType is the reflect.Type of the optionValue interface.
+var optionValueType = reflect.TypeFor[optionValue]()
+
+// isSome returns true if the Option contains a valid value.
+func (o *Option[T]) isSome() bool {
+	return o.ok
+}
+
+// mergeConfig stores config for Merge() function.
+type mergeConfig struct {
+	override bool
+}
+
+type optMerge func(config *mergeConfig)
+
+// Override option makes Some fields of src override dst fields (by default, only None fields of dst are filled).
+func Override() optMerge {
+	return func(cfg *mergeConfig) { cfg.override = true }
+}
+
+// Merge merges src into dst, two structs of the same type (dst must be a pointer, src may be).
+// It walks Option fields recursively (into nested structs and non-nil pointers to structs):
+//   - by default, None fields of dst are filled with Some fields of src;
+//   - with Override(), every Some field of src overrides the dst field.
+//
+// *Option[T] fields are merged the same way, with nil treated as None: a nil dst pointer is allocated,
+// and the value is copied so dst never aliases src. Other fields (Field and Lazy included) are left untouched.
+// Merge returns the dotted paths (e.g. "Server.Port") of dst fields that were set.
+// It fails if src has a pointer cycle (e.g. a node pointing back to its parent), as it can't be walked to the end.
+func Merge(dst, src any, opts ...optMerge) ([]string, error) {
+	cfg := &mergeConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	dv := reflect.ValueOf(dst)
+	if dv.Kind() != reflect.Pointer || dv.IsNil() || dv.Elem().Kind() != reflect.Struct {
+		return nil, fmt.Errorf("dst must be a non-nil pointer to a struct, got %T", dst)
+	}
+	dv = dv.Elem()
+
+	sv := reflect.Indirect(reflect.ValueOf(src))
+	if !sv.IsValid() || sv.Type() != dv.Type() {
+		return nil, fmt.Errorf("src must be a %s or a pointer to it, got %T", dv.Type(), src)
+	}
+
+	if !sv.CanAddr() {
+		// Option methods have pointer receivers, so work on an addressable copy
+		cp := reflect.New(sv.Type()).Elem()
+		cp.Set(sv)
+		sv = cp
+	}
+
+	var set []string
+	visiting := map[mergeVisit]bool{{sv.Addr().Pointer(), sv.Type()}: true}
+	if err := mergeStruct(dv, sv, "", cfg, &set, visiting); err != nil {
+		return nil, err
+	}
+	return set, nil
+}
+
+// mergeVisit identifies a src struct on the current merge path, to detect pointer cycles.
+type mergeVisit struct {
+	ptr uintptr
+	typ reflect.Type
+}
+
+// mergeStruct merges the addressable struct src into the addressable struct dst, collecting set paths.
+// visiting holds the src structs on the current path: reaching one of them again means a pointer cycle.
+func mergeStruct(dst, src reflect.Value, prefix string, cfg *mergeConfig, set *[]string, visiting map[mergeVisit]bool) error {
+	for i := range dst.NumField() {
+		sf := dst.Type().Field(i)
+		if !sf.IsExported() {
+			continue
+		}
+
+		path := prefix + sf.Name
+		df, srcField := dst.Field(i), src.Field(i)
+
+		if so, ok := srcField.Addr().Interface().(optionValue); ok {
+			do, _ := df.Addr().Interface().(optionValue)
+			if so.isSome() && (cfg.override || !do.isSome()) {
+				df.Set(srcField)
+				*set = append(*set, path)
+			}
+			continue
+		}
+
+		if df.Kind() == reflect.Pointer && df.Type().Implements(optionValueType) {
+			if srcField.IsNil() || !srcField.Interface().(optionValue).isSome() {
+				continue
+			}
+			if df.IsNil() {
+				df.Set(reflect.New(df.Type().Elem()))
+			} else if !cfg.override && df.Interface().(optionValue).isSome() {
+				continue
+			}
+			df.Elem().Set(srcField.Elem())
+			*set = append(*set, path)
+			continue
+		}
+
+		switch {
+		case df.Kind() == reflect.Struct:
+			if err := mergeStruct(df, srcField, path+".", cfg, set, visiting); err != nil {
+				return err
+			}
+		case df.Kind() == reflect.Pointer && df.Type().Elem().Kind() == reflect.Struct && !srcField.IsNil():
+			visit := mergeVisit{srcField.Pointer(), srcField.Type().Elem()}
+			if visiting[visit] {
+				return fmt.Errorf("src has a pointer cycle at %s", path)
+			}
+
+			allocated := df.IsNil()
+			if allocated {
+				df.Set(reflect.New(df.Type().Elem()))
+			}
+
+			visiting[visit] = true
+			before := len(*set)
+			err := mergeStruct(df.Elem(), srcField.Elem(), path+".", cfg, set, visiting)
+			delete(visiting, visit)
+			if err != nil {
+				return err
+			}
+			if allocated && len(*set) == before {
+				df.SetZero() // nothing was merged: don't leave an empty allocation behind
+			}
+		}
+	}
+	return nil
+}
+
+// Layer is a named source of values for MergeLayers, e.g. {"env", envConfig}.
+type Layer struct {
+	Name  string
+	Value any
+}
+
+// MergeReport maps dotted field paths (e.g. "Server.Port") to the name of the layer that set them.
+type MergeReport map[string]string
+
+// MergeLayers merges the layers into dst one by one, in the given order (see Merge),
+// and reports which layer set each field. A field set by several layers is reported for the last one.
+//
+// With Override(), list layers from the lowest priority to the highest (defaults → file → env → flags);
+// without it, from the highest to the lowest.
+func MergeLayers(dst any, layers []Layer, opts ...optMerge) (MergeReport, error) {
+	report := make(MergeReport)
+
+	var errs []error
+	for _, layer := range layers {
+		set, err := Merge(dst, layer.Value, opts...)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("layer %s: %w", layer.Name, err))
+			continue
+		}
+		for _, path := range set {
+			report[path] = layer.Name
+		}
+	}
+	return report, errors.Join(errs...)
+}
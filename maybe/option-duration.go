@@ -0,0 +1,9 @@
+package maybe
+
+import "time"
+
+// Duration is just a shortcut for Option[time.Duration].
+type Duration = Option[time.Duration]
+
+// NoneDuration is a shortcut for None[time.Duration].
+func NoneDuration() Duration { return None[time.Duration]() }
@@ -0,0 +1,7 @@
+package maybe
+
+// Float is just a shortcut for Option[float64].
+type Float = Option[float64]
+
+// NoneFloat is a shortcut for None[float64].
+func NoneFloat() Float { return None[float64]() }
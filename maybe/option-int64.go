@@ -0,0 +1,7 @@
+package maybe
+
+// Int64 is just a shortcut for Option[int64].
+type Int64 = Option[int64]
+
+// NoneInt64 is a shortcut for None[int64].
+func NoneInt64() Int64 { return None[int64]() }
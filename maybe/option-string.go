@@ -0,0 +1,7 @@
+package maybe
+
+// String is just a shortcut for Option[string].
+type String = Option[string]
+
+// NoneString is a shortcut for None[string].
+func NoneString() String { return None[string]() }
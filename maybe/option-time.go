@@ -0,0 +1,10 @@
+package maybe
+
+import "time"
+
+// Time is just a shortcut for Option[time.Time].
+// Note: Some(t) checks compare with ==, so prefer Unwrap().Equal(t) when locations or monotonic readings may differ.
+type Time = Option[time.Time]
+
+// NoneTime is a shortcut for None[time.Time].
+func NoneTime() Time { return None[time.Time]() }
@@ -0,0 +1,7 @@
+package maybe
+
+// Uint is just a shortcut for Option[uint].
+type Uint = Option[uint]
+
+// NoneUint is a shortcut for None[uint].
+func NoneUint() Uint { return None[uint]() }
@@ -1,9 +1,12 @@
 package maybe
 
 import (
+	"bytes"
 	"encoding"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"reflect"
 	"strings"
 )
 
@@ -46,6 +49,58 @@ func (o *Option[T]) Unwrap() T {
 	return o.value
 }
 
+// UnwrapOr returns the contained value if present; otherwise, it returns the given default.
+func (o *Option[T]) UnwrapOr(def T) T {
+	if !o.ok {
+		return def
+	}
+	return o.value
+}
+
+// Insert sets the Option to Some(v) and returns a pointer to the contained value.
+// (It's not called Set, as Set(string) implements flag.Value.)
+func (o *Option[T]) Insert(v T) *T {
+	*o = Some(v)
+	return &o.value
+}
+
+// Clear sets the Option to None.
+func (o *Option[T]) Clear() {
+	*o = None[T]()
+}
+
+// Take returns the Option as it was and leaves None in its place.
+func (o *Option[T]) Take() Option[T] {
+	old := *o
+	*o = None[T]()
+	return old
+}
+
+// Replace sets the Option to Some(v) and returns the Option as it was.
+func (o *Option[T]) Replace(v T) Option[T] {
+	old := *o
+	*o = Some(v)
+	return old
+}
+
+// GetOrInsert sets the Option to Some(v) if it is None,
+// then returns a pointer to the contained value.
+func (o *Option[T]) GetOrInsert(v T) *T {
+	if !o.ok {
+		*o = Some(v)
+	}
+	return &o.value
+}
+
+// GetOrInsertWith sets the Option to Some(f()) if it is None (f is not called otherwise),
+// then returns a pointer to the contained value.
+func (o *Option[T]) GetOrInsertWith(f func() T) *T {
+	if !o.ok {
+		*o = Some(f())
+	}
+	return &o.value
+}
+
 // Some constructs an Option that contains a valid value.
 func Some[T comparable](v T) Option[T] {
 	return Option[T]{value: v, ok: true}
@@ -91,9 +146,59 @@ func (o Option[T]) MarshalTOML() ([]byte, error) {
 	return json.Marshal(TomlNone)
 }
 
+// UnmarshalTOML implements the toml.Unmarshaler interface (github.com/BurntSushi/toml).
+// The TomlNone string is decoded as None, other strings are handled as in Set,
+// and native TOML values (integers, floats, booleans, datetimes) are converted into T.
+func (o *Option[T]) UnmarshalTOML(data any) error {
+	if s, ok := data.(string); ok {
+		if strings.EqualFold(strings.TrimSpace(s), TomlNone) {
+			*o = None[T]()
+			return nil
+		}
+		return o.Set(s)
+	}
+
+	var v T
+	if err := convertScalar(data, &v); err != nil {
+		return fmt.Errorf("cannot convert %T into %T: %w", data, v, err)
+	}
+	*o = Some(v)
+	return nil
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// None marshals to empty text; Some(v) is rendered via encoding.TextMarshaler if T implements it,
+// via strconv for scalar kinds (so the output is exactly what UnmarshalText accepts),
+// or via fmt otherwise.
+//
+// Strings that UnmarshalText would read differently (empty, "null", TomlNone, padded with spaces
+// or wrapped in double quotes) are JSON-quoted, so they survive a text round trip.
+func (o Option[T]) MarshalText() ([]byte, error) {
+	if !o.ok {
+		return []byte{}, nil
+	}
+
+	if tm, ok := any(o.value).(encoding.TextMarshaler); ok {
+		return tm.MarshalText()
+	}
+
+	rv := reflect.ValueOf(o.value)
+	if s, ok := formatScalar(rv); ok {
+		if rv.Kind() == reflect.String && isAmbiguousText(s) {
+			return quoteText(s)
+		}
+		return []byte(s), nil
+	}
+
+	return []byte(fmt.Sprint(o.value)), nil
+}
+
 // UnmarshalText implements the encoding.TextUnmarshaler interface.
-// It interprets empty strings or "null" (case-insensitive) as a None value.
-// Otherwise, it attempts to convert the text into type T.
+// It interprets empty strings, "null" or TomlNone (case-insensitive) as a None value.
+// Otherwise, it attempts to convert the text into type T:
+//   - via encoding.TextUnmarshaler if T implements it;
+//   - via time.ParseDuration for time.Duration;
+//   - via strconv for any string, bool, integer or float kind (including custom types).
 func (o *Option[T]) UnmarshalText(text []byte) error {
 	s := strings.TrimSpace(string(text))
 	if s == "" || strings.EqualFold(s, "null") || strings.EqualFold(s, TomlNone) {
@@ -101,44 +206,68 @@ func (o *Option[T]) UnmarshalText(text []byte) error {
 		return nil
 	}
 
+	v, err := parseText[T](text)
+	if err != nil {
+		return err
+	}
+	*o = Some(v)
+	return nil
+}
+
+// isAmbiguousText returns true if the string s, written as is, would not be read back as s by UnmarshalText.
+func isAmbiguousText(s string) bool {
+	return s == "" || s != strings.TrimSpace(s) ||
+		strings.EqualFold(s, "null") || strings.EqualFold(s, TomlNone) ||
+		(len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"')
+}
+
+// quoteText JSON-quotes s (without HTML escaping), the form UnmarshalText unquotes.
+func quoteText(s string) ([]byte, error) {
+	var buf bytes.Buffer
+	enc := json.NewEncoder(&buf)
+	enc.SetEscapeHTML(false)
+	if err := enc.Encode(s); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSuffix(buf.Bytes(), []byte("\n")), nil
+}
+
+// parseText converts the given (non-None) text into T.
+func parseText[T comparable](text []byte) (T, error) {
 	var v T
 	// If T implements encoding.TextUnmarshaler, use it
 	if tm, ok := any(&v).(encoding.TextUnmarshaler); ok {
 		if err := tm.UnmarshalText(text); err != nil {
-			return err
+			return v, err
 		}
-		*o = Some(v)
-		return nil
+		return v, nil
 	}
 
-	var isScalar bool
-	switch any(v).(type) {
-	case float64:
-		isScalar = true
-	case string:
-		isScalar = true
-	case bool:
-		isScalar = true
-	}
-
-	// for scalar, we can re-use json.Unmarshal handler
-	if isScalar {
-		var scalar T
-		if err := json.Unmarshal(text, &scalar); err != nil {
-			return err
+	s := strings.TrimSpace(string(text))
+	if err := parseScalar(s, &v); err != nil {
+		if errors.Is(err, errNoTextFallback) {
+			return v, fmt.Errorf("type %T does not implement encoding.TextUnmarshaler and %w", v, err)
 		}
-		*o = Some(scalar)
-		return nil
+		return v, fmt.Errorf("cannot parse %q as %T: %w", s, v, err)
 	}
-
-	return fmt.Errorf("type %T does not implement encoding.TextUnmarshaler and no fallback conversion is defined", v)
+	return v, nil
 }
 
-// IsZero returns true if Option is none (for `omitzero` interface).
+// IsZero returns true if Option is None (for `omitzero` interface).
+// Some(v) is never zero, even if v is the zero value of T: e.g. False() is a deliberately set value
+// and must survive `omitzero`. Use IsNoneOrZero to treat Some(zero) as empty as well.
+//
+// Note: encoding/json never treats structs as empty, so `omitempty` has no effect on Option fields.
+// Use `omitzero` (Go 1.24+) to omit None fields.
 func (o *Option[T]) IsZero() bool {
+	return o.None()
+}
+
+// IsNoneOrZero returns true if Option is None or contains the zero value of T.
+func (o *Option[T]) IsNoneOrZero() bool {
 	if o.None() {
 		return true
 	}
 	var zero T
-	return o.Unwrap() == zero
+	return o.value == zero
 }
@@ -0,0 +1,216 @@
+package maybe
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"reflect"
+	"strconv"
+	"time"
+)
+
+// errNoTextFallback is returned by parseScalar when T's kind has no text conversion.
+var errNoTextFallback = errors.New("no fallback conversion is defined")
+
+// errIncompatibleTypes is returned by convertScalar when src can't be converted into dst.
+var errIncompatibleTypes = errors.New("incompatible types")
+
+// parseScalar parses s into the scalar pointed to by dst.
+// It works on the reflect.Kind, so custom types (e.g. `type Port uint16`) are supported as well.
+// time.Duration is special-cased to accept time.ParseDuration strings like "1m30s".
+func parseScalar(s string, dst any) error {
+	v := reflect.ValueOf(dst).Elem()
+
+	if v.Type() == reflect.TypeFor[time.Duration]() {
+		d, err := time.ParseDuration(s)
+		if err != nil {
+			return err
+		}
+		v.SetInt(int64(d))
+		return nil
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		// JSON-quoted strings are still accepted (and unquoted) for backward compatibility.
+		if len(s) >= 2 && s[0] == '"' && s[len(s)-1] == '"' {
+			var unquoted string
+			if err := json.Unmarshal([]byte(s), &unquoted); err != nil {
+				return err
+			}
+			s = unquoted
+		}
+		v.SetString(s)
+	case reflect.Bool:
+		b, err := strconv.ParseBool(s)
+		if err != nil {
+			return err
+		}
+		v.SetBool(b)
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		n, err := parseInt(s)
+		if err != nil {
+			return err
+		}
+		if v.OverflowInt(n) {
+			return fmt.Errorf("value %s overflows %s", s, v.Type())
+		}
+		v.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		n, err := parseUint(s)
+		if err != nil {
+			return err
+		}
+		if v.OverflowUint(n) {
+			return fmt.Errorf("value %s overflows %s", s, v.Type())
+		}
+		v.SetUint(n)
+	case reflect.Float32, reflect.Float64:
+		f, err := strconv.ParseFloat(s, v.Type().Bits())
+		if err != nil {
+			return err
+		}
+		v.SetFloat(f)
+	default:
+		return errNoTextFallback
+	}
+
+	return nil
+}
+
+// parseInt parses s as a base-10 integer.
+// Integral floats (e.g. "42.0" or "1e3") are accepted as long as they fit into int64.
+func parseInt(s string) (int64, error) {
+	n, err := strconv.ParseInt(s, 10, 64)
+	if err == nil {
+		return n, nil
+	}
+
+	f, ferr := strconv.ParseFloat(s, 64)
+	if ferr != nil {
+		return 0, err
+	}
+	if n, ok := floatToInt64(f); ok {
+		return n, nil
+	}
+	return 0, err
+}
+
+// parseUint parses s as a base-10 unsigned integer.
+// Integral floats (e.g. "1e19") are accepted as long as they fit into uint64.
+func parseUint(s string) (uint64, error) {
+	n, err := strconv.ParseUint(s, 10, 64)
+	if err == nil {
+		return n, nil
+	}
+
+	f, ferr := strconv.ParseFloat(s, 64)
+	if ferr != nil {
+		return 0, err
+	}
+	if n, ok := floatToUint64(f); ok {
+		return n, nil
+	}
+	return 0, err
+}
+
+// floatToInt64 converts f into int64 if it's integral and within int64 range.
+// The range is checked in float64, as out-of-range float to int conversions are implementation-defined.
+func floatToInt64(f float64) (int64, bool) {
+	if f != math.Trunc(f) || f < -(1<<63) || f >= 1<<63 {
+		return 0, false // NaN fails the first check, infinities fail the range checks
+	}
+	return int64(f), true
+}
+
+// floatToUint64 converts f into uint64 if it's integral and within uint64 range.
+func floatToUint64(f float64) (uint64, bool) {
+	if f != math.Trunc(f) || f < 0 || f >= 1<<64 {
+		return 0, false
+	}
+	return uint64(f), true
+}
+
+// convertScalar converts the decoded scalar src (e.g. int64 or float64 coming from a decoder)
+// into the scalar pointed to by dst, guarding against overflows and non-integral floats.
+func convertScalar(src, dst any) error {
+	sv := reflect.ValueOf(src)
+	dv := reflect.ValueOf(dst).Elem()
+	if !sv.IsValid() {
+		return errIncompatibleTypes
+	}
+
+	// integral floats are converted into integers, if they fit
+	if sv.CanFloat() && (dv.CanInt() || dv.CanUint()) {
+		f := sv.Float()
+		if f != math.Trunc(f) {
+			return fmt.Errorf("value %v is not an integral float", src)
+		}
+		if dv.CanInt() {
+			n, ok := floatToInt64(f)
+			if !ok {
+				return fmt.Errorf("value %v overflows %s", src, dv.Type())
+			}
+			sv = reflect.ValueOf(n)
+		} else {
+			n, ok := floatToUint64(f)
+			if !ok {
+				return fmt.Errorf("value %v overflows %s", src, dv.Type())
+			}
+			sv = reflect.ValueOf(n)
+		}
+	}
+
+	switch {
+	case sv.CanInt() && dv.CanInt():
+		if dv.OverflowInt(sv.Int()) {
+			return fmt.Errorf("value %v overflows %s", src, dv.Type())
+		}
+		dv.SetInt(sv.Int())
+	case sv.CanInt() && dv.CanUint():
+		if sv.Int() < 0 || dv.OverflowUint(uint64(sv.Int())) {
+			return fmt.Errorf("value %v overflows %s", src, dv.Type())
+		}
+		dv.SetUint(uint64(sv.Int()))
+	case sv.CanUint() && dv.CanUint():
+		if dv.OverflowUint(sv.Uint()) {
+			return fmt.Errorf("value %v overflows %s", src, dv.Type())
+		}
+		dv.SetUint(sv.Uint())
+	case sv.CanInt() && dv.CanFloat():
+		dv.SetFloat(float64(sv.Int()))
+	case sv.CanFloat() && dv.CanFloat():
+		dv.SetFloat(sv.Float())
+	case sv.Kind() == dv.Kind() && sv.Type().ConvertibleTo(dv.Type()):
+		// bools, time.Time into custom time types, etc.
+		dv.Set(sv.Convert(dv.Type()))
+	default:
+		return errIncompatibleTypes
+	}
+
+	return nil
+}
+
+// formatScalar is the inverse of parseScalar: it renders the scalar v as text.
+// It returns false if v's kind has no text conversion.
+func formatScalar(v reflect.Value) (string, bool) {
+	if v.Type() == reflect.TypeFor[time.Duration]() {
+		return time.Duration(v.Int()).String(), true
+	}
+
+	switch v.Kind() {
+	case reflect.String:
+		return v.String(), true
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool()), true
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10), true
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return strconv.FormatUint(v.Uint(), 10), true
+	case reflect.Float32, reflect.Float64:
+		return strconv.FormatFloat(v.Float(), 'g', -1, v.Type().Bits()), true
+	default:
+		return "", false
+	}
+}
@@ -0,0 +1,56 @@
+package maybe
+
+import (
+	"fmt"
+	"reflect"
+)
+
+// FromWrapper converts a pointer to a `{ Value T }`-shaped wrapper struct into an Option:
+// nil is None, otherwise it is Some(w.Value).
+// It is designed for protobuf well-known wrappers (e.g. *wrapperspb.Int64Value) without depending on protobuf:
+//
+//	count := maybe.FromWrapper[int64](msg.Count)
+//
+// Panics if W is not a struct with an exported Value field of type T.
+func FromWrapper[T comparable, W any](w *W) Option[T] {
+	field := wrapperValueField[T, W]()
+	if w == nil {
+		return None[T]()
+	}
+
+	// The assertion always succeeds, as the field type is checked to be T.
+	v, _ := reflect.ValueOf(w).Elem().FieldByIndex(field.Index).Interface().(T)
+	return Some(v)
+}
+
+// ToWrapper converts an Option into a pointer to a `{ Value T }`-shaped wrapper struct:
+// None is nil, Some(v) is a new W with Value set to v.
+// It is the inverse of FromWrapper:
+//
+//	msg.Count = maybe.ToWrapper[wrapperspb.Int64Value](count)
+//
+// Panics if W is not a struct with an exported Value field of type T.
+func ToWrapper[W any, T comparable](o Option[T]) *W {
+	field := wrapperValueField[T, W]()
+	if !o.ok {
+		return nil
+	}
+
+	w := new(W)
+	reflect.ValueOf(w).Elem().FieldByIndex(field.Index).Set(reflect.ValueOf(&o.value).Elem())
+	return w
+}
+
+// wrapperValueField returns the Value field of the wrapper type W, checking that it is of type T.
+func wrapperValueField[T comparable, W any]() reflect.StructField {
+	wt := reflect.TypeFor[W]()
+	if wt.Kind() != reflect.Struct {
+		panic(fmt.Sprintf("Expected a wrapper struct! Got <%s>", wt))
+	}
+
+	field, ok := wt.FieldByName("Value")
+	if !ok || !field.IsExported() || field.Type != reflect.TypeFor[T]() {
+		panic(fmt.Sprintf("Expected <%s> to have an exported Value field of type <%s>", wt, reflect.TypeFor[T]()))
+	}
+	return field
+}
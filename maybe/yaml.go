@@ -0,0 +1,44 @@
+package maybe
+
+// YAMLNode is the subset of the YAML v3 node API (*yaml.Node of go.yaml.in/yaml/v3 or gopkg.in/yaml.v3)
+// that Option needs. Declaring it as an interface keeps k1 free of YAML dependencies.
+type YAMLNode interface {
+	Decode(v any) error
+}
+
+// MarshalYAML implements the yaml.Marshaler interface (same for YAML v2 and v3).
+// If the Option is None, it marshals to YAML null; otherwise, it marshals to the contained value.
+func (o Option[T]) MarshalYAML() (any, error) {
+	if !o.ok {
+		return nil, nil //nolint:nilnil // nil is how YAML null is marshalled
+	}
+	return o.value, nil
+}
+
+// UnmarshalYAML implements the YAML v2 yaml.Unmarshaler interface
+// (YAML v3 supports it as well, as an obsolete unmarshaler).
+// YAML null (`null`, `~` or an empty value) sets the Option to None; otherwise, it unmarshals into the contained value.
+// Missing keys leave the Option untouched, so a zero Option stays None.
+//
+// Note: YAML v3 doesn't call unmarshalers for null values at all and leaves the field as it was,
+// which is None for a freshly declared struct.
+func (o *Option[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var v *T
+	if err := unmarshal(&v); err != nil {
+		return err
+	}
+
+	if v == nil {
+		*o = None[T]()
+		return nil
+	}
+	*o = Some(*v)
+	return nil
+}
+
+// UnmarshalYAMLNode unmarshals a YAML v3 node into the Option, following UnmarshalYAML semantics.
+// It is a shim for YAML v3 unmarshalers: a type with `UnmarshalYAML(node *yaml.Node) error`
+// can delegate to it for its Option fields.
+func (o *Option[T]) UnmarshalYAMLNode(node YAMLNode) error {
+	return o.UnmarshalYAML(node.Decode)
+}
@@ -0,0 +1,32 @@
+package quick
+
+import "github.com/amberpixels/k1/set"
+
+// AppendBy appends elements of b to the slice a, skipping elements whose key (as returned by key)
+// was already seen. It's Append for non-comparable elements, or for deduplicating by an ID:
+// the first element for each key wins. As Append, it respects the old duplicates of a.
+func AppendBy[T any, K comparable](a []T, key func(T) K, b ...T) []T {
+	m := len(b)
+	if m == 0 {
+		return a
+	}
+	n := len(a)
+
+	seen := set.NewLookupCapped[K](n + m)
+	for _, e := range a {
+		seen.Add(key(e))
+	}
+
+	res := make([]T, n, n+m)
+	copy(res, a)
+
+	for _, e := range b {
+		k := key(e)
+		if !seen.Has(k) {
+			seen.Add(k)
+			res = append(res, e)
+		}
+	}
+
+	return res
+}
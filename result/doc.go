@@ -0,0 +1,22 @@
+// Package result provides Result[T], a generic container for a value or an error.
+//
+// Result[T] complements maybe.Option[T]: Option models presence, Result models failure.
+// It lets pipelines of fallible conversions be composed without nested `if err != nil`:
+//
+//   - Construction via Ok(v), Err[T](err), Of(v, err) (from a (T, error) pair) and Try(f),
+//     which turns panics (e.g. from cast.As* functions) into errors.
+//   - Querying with IsOk() and IsErr(); unwrapping with Unwrap() and UnwrapErr(), which panic
+//     on the wrong variant, or Get() returning (T, error).
+//   - Composition via Map and AndThen.
+//   - Conversion to and from maybe.Option via ToOption and FromOption (None becomes the given error, or ErrNone).
+//   - errors.Is / errors.As passthrough via Is and As methods.
+//
+// Usage:
+//
+//	import "github.com/amberpixels/k1/result"
+//
+//	port := result.AndThen(result.Of(strconv.Atoi(s)), validatePort)
+//	if port.IsErr() {
+//	    return port.Err()
+//	}
+package result
@@ -0,0 +1,146 @@
+package result
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/amberpixels/k1/maybe"
+)
+
+// ErrNone is the error of Results converted by FromOption from a None when no error is given.
+var ErrNone = errors.New("option is None")
+
+// Result represents either a successful value of type T (Ok) or an error (Err).
+// The zero value of Result is Ok with the zero value of T.
+type Result[T any] struct {
+	value T
+	err   error
+}
+
+// Ok constructs a successful Result that contains the given value.
+func Ok[T any](v T) Result[T] {
+	return Result[T]{value: v}
+}
+
+// Err constructs a failed Result that contains the given error.
+// Panics if err is nil.
+func Err[T any](err error) Result[T] {
+	if err == nil {
+		panic("called Err with a nil error")
+	}
+	return Result[T]{err: err}
+}
+
+// Of constructs a Result from a (T, error) pair, so it can wrap calls directly:
+//
+//	n := result.Of(strconv.Atoi(s))
+func Of[T any](v T, err error) Result[T] {
+	if err != nil {
+		return Err[T](err)
+	}
+	return Ok(v)
+}
+
+// Try calls f and returns its value as Ok.
+// If f panics, the panic is recovered and returned as Err.
+// It bridges panicking helpers (e.g. cast.As* functions) into Results.
+//
+//nolint:nonamedreturns // named return needed for panic-recovery
+func Try[T any](f func() T) (r Result[T]) {
+	defer func() {
+		if rec := recover(); rec != nil {
+			if err, ok := rec.(error); ok {
+				r = Err[T](err)
+				return
+			}
+			r = Err[T](fmt.Errorf("panic: %v", rec))
+		}
+	}()
+
+	return Ok(f())
+}
+
+// IsOk returns true if the Result contains a value.
+func (r *Result[T]) IsOk() bool {
+	return r.err == nil
+}
+
+// IsErr returns true if the Result contains an error.
+func (r *Result[T]) IsErr() bool {
+	return r.err != nil
+}
+
+// Unwrap returns the contained value if Ok; otherwise, it panics with the contained error.
+func (r *Result[T]) Unwrap() T {
+	if r.err != nil {
+		panic(fmt.Errorf("called Unwrap on an Err Result: %w", r.err))
+	}
+	return r.value
+}
+
+// UnwrapErr returns the contained error if Err; otherwise, it panics.
+func (r *Result[T]) UnwrapErr() error {
+	if r.err == nil {
+		panic("called UnwrapErr on an Ok Result")
+	}
+	return r.err
+}
+
+// Err returns the contained error, or nil if the Result is Ok.
+func (r *Result[T]) Err() error {
+	return r.err
+}
+
+// Get returns the Result as a (T, error) pair.
+// The value is the zero value of T if the Result is Err.
+func (r *Result[T]) Get() (T, error) {
+	return r.value, r.err
+}
+
+// Is reports whether the contained error matches target (see errors.Is).
+// It is always false for Ok.
+func (r *Result[T]) Is(target error) bool {
+	return r.err != nil && errors.Is(r.err, target)
+}
+
+// As finds the first error in the contained error's chain that matches target (see errors.As).
+// It is always false for Ok.
+func (r *Result[T]) As(target any) bool {
+	return r.err != nil && errors.As(r.err, target)
+}
+
+// Map applies f to the value of an Ok Result. An Err Result is passed through.
+func Map[T, U any](r Result[T], f func(T) U) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return Ok(f(r.value))
+}
+
+// AndThen chains a fallible f onto an Ok Result. An Err Result is passed through, f is not called.
+func AndThen[T, U any](r Result[T], f func(T) Result[U]) Result[U] {
+	if r.err != nil {
+		return Err[U](r.err)
+	}
+	return f(r.value)
+}
+
+// ToOption converts the Result into an Option: Some for Ok, None for Err (the error is dropped).
+func ToOption[T comparable](r Result[T]) maybe.Option[T] {
+	if r.err != nil {
+		return maybe.None[T]()
+	}
+	return maybe.Some(r.value)
+}
+
+// FromOption converts the Option into a Result: Ok for Some, Err(errNone) for None.
+// A nil errNone falls back to ErrNone.
+func FromOption[T comparable](o maybe.Option[T], errNone error) Result[T] {
+	if o.None() {
+		if errNone == nil {
+			errNone = ErrNone
+		}
+		return Err[T](errNone)
+	}
+	return Ok(o.Unwrap())
+}
@@ -0,0 +1,161 @@
+package set
+
+import (
+	"cmp"
+	"fmt"
+	"iter"
+	"maps"
+	"slices"
+	"strings"
+)
+
+// Bag is a multiset: a map of elements to the number of their occurrences.
+// Elements with no occurrences are never stored, so len(b) is the number of distinct elements.
+type Bag[T comparable] map[T]int
+
+// BagEntry is an element of a Bag with its count.
+type BagEntry[T comparable] struct {
+	Value T
+	Count int
+}
+
+// NewBag returns new ready to use bag, counting the given initial elements.
+func NewBag[T comparable](initial ...T) Bag[T] {
+	b := make(Bag[T], len(initial))
+	for _, v := range initial {
+		b.Add(v, 1)
+	}
+	return b
+}
+
+// Add adds n occurrences of an element into the bag. Non-positive n is a no-op.
+func (b Bag[T]) Add(v T, n int) {
+	if n <= 0 {
+		return
+	}
+	b[v] += n
+}
+
+// Remove removes n occurrences of an element from the bag (down to zero). Non-positive n is a no-op.
+func (b Bag[T]) Remove(v T, n int) {
+	if n <= 0 {
+		return
+	}
+	if b[v] <= n {
+		delete(b, v)
+		return
+	}
+	b[v] -= n
+}
+
+// Delete removes all the occurrences of an element from the bag.
+func (b Bag[T]) Delete(v T) {
+	delete(b, v)
+}
+
+// Clear removes all the elements from the bag.
+func (b Bag[T]) Clear() {
+	clear(b)
+}
+
+// Has returns true if the bag has at least one occurrence of an element.
+func (b Bag[T]) Has(v T) bool {
+	return b[v] > 0
+}
+
+// Count returns the number of occurrences of an element.
+func (b Bag[T]) Count(v T) int {
+	return b[v]
+}
+
+// Len returns the number of distinct elements in the bag.
+func (b Bag[T]) Len() int {
+	return len(b)
+}
+
+// Total returns the total number of occurrences of all the elements.
+func (b Bag[T]) Total() int {
+	total := 0
+	for _, n := range b {
+		total += n
+	}
+	return total
+}
+
+// All returns an iterator over the distinct elements of the bag and their counts, in no particular order.
+func (b Bag[T]) All() iter.Seq2[T, int] {
+	return maps.All(b)
+}
+
+// MostCommon returns the n most common elements with their counts, most common first.
+// Ties are broken by the element's natural order for ordered kinds (strings, numbers, bools)
+// and by its fmt representation otherwise, so the result is deterministic.
+// Negative n returns all the elements.
+func (b Bag[T]) MostCommon(n int) []BagEntry[T] {
+	res := make([]BagEntry[T], 0, len(b))
+	for v, c := range b {
+		res = append(res, BagEntry[T]{Value: v, Count: c})
+	}
+
+	tie := kindCompare[T]()
+	if tie == nil {
+		tie = func(x, y T) int { return strings.Compare(fmt.Sprint(x), fmt.Sprint(y)) }
+	}
+	slices.SortFunc(res, func(x, y BagEntry[T]) int {
+		if c := cmp.Compare(y.Count, x.Count); c != 0 {
+			return c
+		}
+		return tie(x.Value, y.Value)
+	})
+
+	if n >= 0 && n < len(res) {
+		res = res[:n]
+	}
+	return res
+}
+
+// Union returns a new bag where every element has the maximum of its counts in b and other.
+func (b Bag[T]) Union(other Bag[T]) Bag[T] {
+	res := maps.Clone(b)
+	if res == nil {
+		res = make(Bag[T], len(other))
+	}
+	for v, n := range other {
+		res[v] = max(res[v], n)
+	}
+	return res
+}
+
+// Intersection returns a new bag where every element has the minimum of its counts in b and other.
+func (b Bag[T]) Intersection(other Bag[T]) Bag[T] {
+	small, big := b, other
+	if len(small) > len(big) {
+		small, big = big, small
+	}
+
+	res := make(Bag[T], len(small))
+	for v, n := range small {
+		if m := big[v]; m > 0 {
+			res[v] = min(n, m)
+		}
+	}
+	return res
+}
+
+// Lookup returns the distinct elements of the bag as a new lookup.
+func (b Bag[T]) Lookup() Lookup[T] {
+	res := NewLookupCapped[T](len(b))
+	for v := range b {
+		res.Add(v)
+	}
+	return res
+}
+
+// Bag returns a new bag with every element of the lookup counted once.
+func (l Lookup[T]) Bag() Bag[T] {
+	res := make(Bag[T], len(l))
+	for v := range l {
+		res[v] = 1
+	}
+	return res
+}
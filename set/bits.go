@@ -0,0 +1,216 @@
+package set
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+)
+
+// Integer is a constraint for the element types of Bits.
+type Integer interface {
+	~int | ~int8 | ~int16 | ~int32 | ~int64 | ~uint | ~uint8 | ~uint16 | ~uint32 | ~uint64 | ~uintptr
+}
+
+// wordSize is the number of bits in a Bits word.
+const wordSize = 64
+
+// Bits is a compact set of small non-negative integers (e.g. enum-like flags or dense IDs), one bit per value.
+// Its memory is proportional to the largest element, so it's a drop-in replacement of Lookup for small domains only.
+// It shares the method names of Lookup; iteration is in ascending order.
+// The zero value of Bits is an empty set ready to use.
+type Bits[T Integer] struct {
+	words []uint64
+}
+
+// NewBits returns new ready to use bitset with the given initial elements.
+func NewBits[T Integer](initial ...T) *Bits[T] {
+	b := &Bits[T]{}
+	for _, v := range initial {
+		b.Add(v)
+	}
+	return b
+}
+
+// Has returns true if the bitset has the given element.
+func (b *Bits[T]) Has(v T) bool {
+	i, mask, ok := bitOf(v)
+	return ok && i < len(b.words) && b.words[i]&mask != 0
+}
+
+// Add adds an element into the bitset. It panics on negative elements.
+func (b *Bits[T]) Add(v T) {
+	i, mask, ok := bitOf(v)
+	if !ok {
+		panic(fmt.Sprintf("Expected a non-negative element! Got <%T>: %d", v, v))
+	}
+	b.grow(i + 1)
+	b.words[i] |= mask
+}
+
+// Delete deletes an element from the bitset.
+func (b *Bits[T]) Delete(v T) {
+	i, mask, ok := bitOf(v)
+	if ok && i < len(b.words) {
+		b.words[i] &^= mask
+	}
+}
+
+// Clear removes all the elements from the bitset.
+func (b *Bits[T]) Clear() {
+	clear(b.words)
+	b.words = b.words[:0]
+}
+
+// Len returns the number of elements in the bitset.
+func (b *Bits[T]) Len() int {
+	n := 0
+	for _, w := range b.words {
+		n += bits.OnesCount64(w)
+	}
+	return n
+}
+
+// All returns an iterator over the elements of the bitset, in ascending order.
+func (b *Bits[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for i, w := range b.words {
+			for w != 0 {
+				if !yield(T(i*wordSize + bits.TrailingZeros64(w))) {
+					return
+				}
+				w &= w - 1
+			}
+		}
+	}
+}
+
+// Slice returns the elements of the bitset as a new slice, in ascending order.
+func (b *Bits[T]) Slice() []T {
+	res := make([]T, 0, b.Len())
+	for v := range b.All() {
+		res = append(res, v)
+	}
+	return res
+}
+
+// Union returns a new bitset with the elements that are in b or in other.
+func (b *Bits[T]) Union(other *Bits[T]) *Bits[T] {
+	res := b.clone()
+	res.UnionWith(other)
+	return res
+}
+
+// UnionWith adds all the elements of other into b.
+func (b *Bits[T]) UnionWith(other *Bits[T]) {
+	b.grow(len(other.words))
+	for i, w := range other.words {
+		b.words[i] |= w
+	}
+}
+
+// Intersection returns a new bitset with the elements that are both in b and in other.
+func (b *Bits[T]) Intersection(other *Bits[T]) *Bits[T] {
+	res := b.clone()
+	res.IntersectionWith(other)
+	return res
+}
+
+// IntersectionWith removes from b all the elements that are not in other.
+func (b *Bits[T]) IntersectionWith(other *Bits[T]) {
+	for i := range b.words {
+		b.words[i] &= other.word(i)
+	}
+}
+
+// Difference returns a new bitset with the elements of b that are not in other.
+func (b *Bits[T]) Difference(other *Bits[T]) *Bits[T] {
+	res := b.clone()
+	res.DifferenceWith(other)
+	return res
+}
+
+// DifferenceWith removes from b all the elements that are in other.
+func (b *Bits[T]) DifferenceWith(other *Bits[T]) {
+	for i := range min(len(b.words), len(other.words)) {
+		b.words[i] &^= other.words[i]
+	}
+}
+
+// SymmetricDifference returns a new bitset with the elements that are either in b or in other, but not in both.
+func (b *Bits[T]) SymmetricDifference(other *Bits[T]) *Bits[T] {
+	res := b.clone()
+	res.SymmetricDifferenceWith(other)
+	return res
+}
+
+// SymmetricDifferenceWith makes b contain the elements that were either in b or in other, but not in both.
+func (b *Bits[T]) SymmetricDifferenceWith(other *Bits[T]) {
+	b.grow(len(other.words))
+	for i, w := range other.words {
+		b.words[i] ^= w
+	}
+}
+
+// IsSubset returns true if every element of b is in other.
+func (b *Bits[T]) IsSubset(other *Bits[T]) bool {
+	for i, w := range b.words {
+		if w&^other.word(i) != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if every element of other is in b.
+func (b *Bits[T]) IsSuperset(other *Bits[T]) bool {
+	return other.IsSubset(b)
+}
+
+// IsDisjoint returns true if b and other have no elements in common.
+func (b *Bits[T]) IsDisjoint(other *Bits[T]) bool {
+	for i := range min(len(b.words), len(other.words)) {
+		if b.words[i]&other.words[i] != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if b and other contain the same elements.
+func (b *Bits[T]) Equal(other *Bits[T]) bool {
+	for i := range max(len(b.words), len(other.words)) {
+		if b.word(i) != other.word(i) {
+			return false
+		}
+	}
+	return true
+}
+
+// bitOf returns the word index and the bit mask of v. It returns false for negative values.
+func bitOf[T Integer](v T) (int, uint64, bool) {
+	if v < 0 {
+		return 0, 0, false
+	}
+	u := uint64(v)
+	return int(u / wordSize), 1 << (u % wordSize), true
+}
+
+// word returns the i-th word, or zero if it's beyond the bitset.
+func (b *Bits[T]) word(i int) uint64 {
+	if i < len(b.words) {
+		return b.words[i]
+	}
+	return 0
+}
+
+// grow makes sure the bitset has at least n words.
+func (b *Bits[T]) grow(n int) {
+	if n > len(b.words) {
+		b.words = append(b.words, make([]uint64, n-len(b.words))...)
+	}
+}
+
+// clone returns a copy of b.
+func (b *Bits[T]) clone() *Bits[T] {
+	return &Bits[T]{words: append([]uint64(nil), b.words...)}
+}
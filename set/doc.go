@@ -0,0 +1,49 @@
+// Package set provides lightweight generic set types.
+//
+// It offers:
+//   - Lookup[T]: a map[T]struct{} with Has, Add, Delete, Clear and Len, built via NewLookup or NewLookupCapped.
+//   - Set algebra on Lookup: Union, Intersection, Difference and SymmetricDifference return new lookups,
+//     their ...With counterparts (UnionWith, IntersectionWith, ...) modify the receiver in place.
+//     Wherever possible, the smaller of the two lookups is iterated.
+//   - Predicates: IsSubset, IsSuperset, IsDisjoint and Equal.
+//   - Exporting: All (iter.Seq), Slice, SortedFunc and SortedSlice for ordered elements;
+//     Collect and FromSlice build a lookup from an iterator or a slice.
+//   - JSON and YAML marshalling (no YAML dependency): a lookup encodes as a sorted array
+//     (natural order for ordered kinds, so the output is deterministic) and decodes from one, collapsing duplicates.
+//     FromJSON with RejectDuplicates() fails on duplicates instead.
+//   - Text marshalling: comma-separated elements, e.g. for env vars or flag.TextVar; see FromText.
+//   - Ordered[T]: an insertion-ordered set (O(1) Has, amortised O(log n) Delete) with Index, At
+//     and Pop (O(1), or O(log n) after deletions from the middle),
+//     and the same set algebra as Lookup (results keep the order of the receiver).
+//   - Sync[T]: a set safe for concurrent use (a Lookup behind a sync.RWMutex) with atomic AddIfAbsent,
+//     sync.Map-style LoadOrStore and LoadAndDelete, and Snapshot/All iterating over a copy.
+//     Whether it beats sync.Map depends on the load and the core count: measure with BenchmarkSyncVsSyncMap (-cpu 1,8).
+//   - Bag[T]: a multiset (map[T]int) with Add(v, n), Remove, Count, Total, MostCommon (deterministic ties),
+//     Union (max counts) and Intersection (min counts); Bag.Lookup and Lookup.Bag convert between the two.
+//   - Bits[T]: a bitset for small non-negative integer domains (enums, dense IDs) with the method names
+//     of Lookup, popcount-based Len, word-wise algebra and ascending iteration.
+//   - Sorted[T]: an ordered set (AVL tree) for cmp.Ordered elements with Min, Max, Floor, Ceiling,
+//     Range(lo, hi) over [lo, hi), ascending iteration and the same set algebra as Lookup.
+//   - KeyedLookup[K, V]: deduplicates (possibly non-comparable) values by a key function, keeping the first
+//     value for each key; see also quick.AppendBy.
+//
+// All the sets but Bag implement the Set[T] interface (Has, Add, Delete, Len, All and Clear), so code can accept
+// any of them. The generic Union, Intersection, Difference and SymmetricDifference functions fill a destination set
+// of any implementation, and IsSubset, IsSuperset, IsDisjoint and Equal compare sets of different implementations.
+//
+// Usage:
+//
+//	import "github.com/amberpixels/k1/set"
+//
+//	admins := set.NewLookup("alice", "bob")
+//	online := set.NewLookup("bob", "carol")
+//
+//	onlineAdmins := admins.Intersection(online) // {bob}
+//	admins.UnionWith(online)                    // admins is {alice, bob, carol} now
+//
+//	set.SortedSlice(admins) // []string{"alice", "bob", "carol"}
+//
+//	everyone := set.Union(set.NewSorted[string](), admins, online) // *set.Sorted[string]
+//
+// Package set is intended as a lightweight helper for set-like lookups.
+package set
@@ -0,0 +1,270 @@
+package set
+
+import (
+	"bytes"
+	"cmp"
+	"encoding"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+)
+
+// decodeConfig stores config for FromJSON() and FromText() functions.
+type decodeConfig struct {
+	rejectDuplicates bool
+}
+
+type optDecode func(config *decodeConfig)
+
+// RejectDuplicates option makes decoding fail on duplicate elements (by default, duplicates are collapsed).
+func RejectDuplicates() optDecode {
+	return func(cfg *decodeConfig) { cfg.rejectDuplicates = true }
+}
+
+// MarshalJSON implements the json.Marshaler interface.
+// The lookup marshals to a JSON array (null for a nil lookup), sorted so the output is deterministic:
+// in natural order for elements of ordered kinds (strings, numbers, bools), by their JSON encoding otherwise.
+func (l Lookup[T]) MarshalJSON() ([]byte, error) {
+	if l == nil {
+		return []byte("null"), nil
+	}
+
+	elems, err := encodeSorted(l, func(v T) ([]byte, error) { return json.Marshal(v) })
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	buf.WriteByte('[')
+	for i, e := range elems {
+		if i > 0 {
+			buf.WriteByte(',')
+		}
+		buf.Write(e)
+	}
+	buf.WriteByte(']')
+	return buf.Bytes(), nil
+}
+
+// UnmarshalJSON implements the json.Unmarshaler interface.
+// It replaces the lookup with the elements of a JSON array, collapsing duplicates; JSON null makes it nil.
+// Use FromJSON with RejectDuplicates() to fail on duplicates instead.
+func (l *Lookup[T]) UnmarshalJSON(data []byte) error {
+	res, err := FromJSON[T](data)
+	if err != nil {
+		return err
+	}
+	*l = res
+	return nil
+}
+
+// FromJSON builds a new lookup from a JSON array (nil for JSON null).
+func FromJSON[T comparable](data []byte, opts ...optDecode) (Lookup[T], error) {
+	var elems []T
+	if err := json.Unmarshal(data, &elems); err != nil {
+		return nil, err
+	}
+	if elems == nil {
+		return nil, nil
+	}
+	return fromElements(elems, opts...)
+}
+
+// MarshalText implements the encoding.TextMarshaler interface.
+// The lookup marshals to its comma-separated elements, sorted as in MarshalJSON.
+// Elements are formatted via encoding.TextMarshaler if available, as is for strings and as JSON otherwise;
+// it returns an error if any element's text is empty, space-padded or contains a comma, as FromText would lose it.
+func (l Lookup[T]) MarshalText() ([]byte, error) {
+	elems, err := encodeSorted(l, marshalElementText[T])
+	if err != nil {
+		return nil, err
+	}
+	return bytes.Join(elems, []byte(",")), nil
+}
+
+// UnmarshalText implements the encoding.TextUnmarshaler interface, so lookups can be read from env vars
+// or bound to flags via flag.TextVar. It replaces the lookup with the comma-separated elements of text:
+// elements are trimmed, empty ones are skipped and duplicates are collapsed.
+// Use FromText with RejectDuplicates() to fail on duplicates instead.
+//
+// Note: elements themselves can't contain commas.
+func (l *Lookup[T]) UnmarshalText(text []byte) error {
+	res, err := FromText[T](string(text))
+	if err != nil {
+		return err
+	}
+	*l = res
+	return nil
+}
+
+// FromText builds a new lookup from comma-separated elements, following UnmarshalText semantics.
+// Elements are parsed via encoding.TextUnmarshaler if available, as is for strings and as JSON otherwise
+// (so numbers and bools are supported).
+func FromText[T comparable](text string, opts ...optDecode) (Lookup[T], error) {
+	elems := []T{}
+	for part := range strings.SplitSeq(text, ",") {
+		part = strings.TrimSpace(part)
+		if part == "" {
+			continue
+		}
+
+		v, err := unmarshalElementText[T](part)
+		if err != nil {
+			return nil, err
+		}
+		elems = append(elems, v)
+	}
+	return fromElements(elems, opts...)
+}
+
+// MarshalYAML implements the yaml.Marshaler interface (same for YAML v2 and v3).
+// The lookup marshals to a YAML sequence, sorted as in MarshalJSON.
+func (l Lookup[T]) MarshalYAML() (any, error) {
+	if l == nil {
+		return nil, nil //nolint:nilnil // nil is how YAML null is marshalled
+	}
+
+	elems := l.Slice()
+	if c := kindCompare[T](); c != nil {
+		slices.SortFunc(elems, c)
+	} else {
+		slices.SortFunc(elems, func(a, b T) int { return strings.Compare(fmt.Sprint(a), fmt.Sprint(b)) })
+	}
+	return elems, nil
+}
+
+// UnmarshalYAML implements the YAML v2 yaml.Unmarshaler interface
+// (YAML v3 supports it as well, as an obsolete unmarshaler).
+// It replaces the lookup with the elements of a YAML sequence, collapsing duplicates.
+func (l *Lookup[T]) UnmarshalYAML(unmarshal func(any) error) error {
+	var elems []T
+	if err := unmarshal(&elems); err != nil {
+		return err
+	}
+
+	res, err := fromElements(elems)
+	if err != nil {
+		return err
+	}
+	*l = res
+	return nil
+}
+
+// fromElements builds a new lookup from elems, following the decoding options.
+func fromElements[T comparable](elems []T, opts ...optDecode) (Lookup[T], error) {
+	cfg := &decodeConfig{}
+	for _, opt := range opts {
+		opt(cfg)
+	}
+
+	res := NewLookupCapped[T](len(elems))
+	for _, v := range elems {
+		if cfg.rejectDuplicates && res.Has(v) {
+			return nil, fmt.Errorf("duplicate element %v", v)
+		}
+		res.Add(v)
+	}
+	return res, nil
+}
+
+// encodeSorted encodes every element of l, sorted in natural order for ordered kinds
+// or by the encoded form otherwise.
+func encodeSorted[T comparable](l Lookup[T], encode func(T) ([]byte, error)) ([][]byte, error) {
+	elems := l.Slice()
+	c := kindCompare[T]()
+	if c != nil {
+		slices.SortFunc(elems, c)
+	}
+
+	res := make([][]byte, 0, len(elems))
+	for _, v := range elems {
+		b, err := encode(v)
+		if err != nil {
+			return nil, err
+		}
+		res = append(res, b)
+	}
+
+	if c == nil {
+		slices.SortFunc(res, bytes.Compare)
+	}
+	return res, nil
+}
+
+// kindCompare returns a comparison function for T if its underlying kind is ordered; otherwise, nil.
+func kindCompare[T comparable]() func(a, b T) int {
+	switch reflect.TypeFor[T]().Kind() {
+	case reflect.String:
+		return func(a, b T) int { return strings.Compare(reflect.ValueOf(a).String(), reflect.ValueOf(b).String()) }
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return func(a, b T) int { return cmp.Compare(reflect.ValueOf(a).Int(), reflect.ValueOf(b).Int()) }
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		return func(a, b T) int { return cmp.Compare(reflect.ValueOf(a).Uint(), reflect.ValueOf(b).Uint()) }
+	case reflect.Float32, reflect.Float64:
+		return func(a, b T) int { return cmp.Compare(reflect.ValueOf(a).Float(), reflect.ValueOf(b).Float()) }
+	case reflect.Bool:
+		return func(a, b T) int {
+			x, y := reflect.ValueOf(a).Bool(), reflect.ValueOf(b).Bool()
+			switch {
+			case x == y:
+				return 0
+			case !x:
+				return -1
+			default:
+				return 1
+			}
+		}
+	default:
+		return nil
+	}
+}
+
+// marshalElementText formats a single element for MarshalText.
+// It fails on elements that wouldn't round-trip through FromText: empty, space-padded or containing a comma.
+func marshalElementText[T comparable](v T) ([]byte, error) {
+	var text []byte
+	var err error
+	if tm, ok := any(v).(encoding.TextMarshaler); ok {
+		text, err = tm.MarshalText()
+	} else if rv := reflect.ValueOf(v); rv.Kind() == reflect.String {
+		text = []byte(rv.String())
+	} else {
+		text, err = json.Marshal(v)
+	}
+	if err != nil {
+		return nil, err
+	}
+	switch {
+	case len(text) == 0:
+		return nil, errors.New("element has empty text")
+	case bytes.IndexByte(text, ',') >= 0:
+		return nil, fmt.Errorf("element %q contains a comma", text)
+	case len(bytes.TrimSpace(text)) != len(text):
+		return nil, fmt.Errorf("element %q has leading or trailing spaces", text)
+	}
+	return text, nil
+}
+
+// unmarshalElementText parses a single element for UnmarshalText.
+func unmarshalElementText[T comparable](s string) (T, error) {
+	var v T
+	if tu, ok := any(&v).(encoding.TextUnmarshaler); ok {
+		if err := tu.UnmarshalText([]byte(s)); err != nil {
+			return v, err
+		}
+		return v, nil
+	}
+
+	if rv := reflect.ValueOf(&v).Elem(); rv.Kind() == reflect.String {
+		rv.SetString(s)
+		return v, nil
+	}
+
+	if err := json.Unmarshal([]byte(s), &v); err != nil {
+		return v, fmt.Errorf("cannot parse %q as %T: %w", s, v, err)
+	}
+	return v, nil
+}
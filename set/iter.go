@@ -0,0 +1,51 @@
+package set
+
+import (
+	"cmp"
+	"iter"
+	"maps"
+	"slices"
+)
+
+// All returns an iterator over the elements of the lookup, in no particular order.
+func (l Lookup[T]) All() iter.Seq[T] {
+	return maps.Keys(l)
+}
+
+// Slice returns the elements of the lookup as a new slice, in no particular order.
+func (l Lookup[T]) Slice() []T {
+	res := make([]T, 0, len(l))
+	for k := range l {
+		res = append(res, k)
+	}
+	return res
+}
+
+// SortedFunc returns the elements of the lookup as a new slice, sorted by the given comparison function.
+func (l Lookup[T]) SortedFunc(compare func(a, b T) int) []T {
+	res := l.Slice()
+	slices.SortFunc(res, compare)
+	return res
+}
+
+// SortedSlice returns the elements of the lookup as a new slice, sorted in ascending order.
+// Note: it's a function, as methods can't narrow T down to cmp.Ordered.
+func SortedSlice[T cmp.Ordered](l Lookup[T]) []T {
+	res := l.Slice()
+	slices.Sort(res)
+	return res
+}
+
+// Collect builds a new lookup from the values of seq.
+func Collect[T comparable](seq iter.Seq[T]) Lookup[T] {
+	l := NewLookup[T]()
+	for v := range seq {
+		l.Add(v)
+	}
+	return l
+}
+
+// FromSlice builds a new lookup from the elements of s.
+func FromSlice[T comparable](s []T) Lookup[T] {
+	return NewLookupCapped(len(s), s...)
+}
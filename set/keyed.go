@@ -0,0 +1,73 @@
+package set
+
+import (
+	"iter"
+	"maps"
+)
+
+// KeyedLookup is a lookup of values deduplicated by a derived key, e.g. records by their ID.
+// Values don't have to be comparable, only their keys. The first value added for a key is kept.
+// Use NewKeyedLookup to create one: the zero value has no key function.
+type KeyedLookup[K comparable, V any] struct {
+	key func(V) K
+	m   map[K]V
+}
+
+// NewKeyedLookup returns new ready to use keyed lookup with the given key function and initial values.
+func NewKeyedLookup[K comparable, V any](key func(V) K, initial ...V) *KeyedLookup[K, V] {
+	kl := &KeyedLookup[K, V]{key: key, m: make(map[K]V, len(initial))}
+	for _, v := range initial {
+		kl.Add(v)
+	}
+	return kl
+}
+
+// Has returns true if the lookup has a value with the same key as v.
+func (kl *KeyedLookup[K, V]) Has(v V) bool {
+	return kl.HasKey(kl.key(v))
+}
+
+// HasKey returns true if the lookup has a value with the given key.
+func (kl *KeyedLookup[K, V]) HasKey(k K) bool {
+	_, ok := kl.m[k]
+	return ok
+}
+
+// Get returns the value stored for the given key.
+func (kl *KeyedLookup[K, V]) Get(k K) (V, bool) {
+	v, ok := kl.m[k]
+	return v, ok
+}
+
+// Add adds a value into the lookup, unless there is already a value with the same key (the first one is kept).
+func (kl *KeyedLookup[K, V]) Add(v V) {
+	k := kl.key(v)
+	if _, ok := kl.m[k]; !ok {
+		kl.m[k] = v
+	}
+}
+
+// Delete deletes the value with the same key as v from the lookup.
+func (kl *KeyedLookup[K, V]) Delete(v V) {
+	delete(kl.m, kl.key(v))
+}
+
+// Clear removes all the values from the lookup.
+func (kl *KeyedLookup[K, V]) Clear() {
+	clear(kl.m)
+}
+
+// Len returns the number of values in the lookup.
+func (kl *KeyedLookup[K, V]) Len() int {
+	return len(kl.m)
+}
+
+// All returns an iterator over the values of the lookup, in no particular order.
+func (kl *KeyedLookup[K, V]) All() iter.Seq[V] {
+	return maps.Values(kl.m)
+}
+
+// Keys returns a new lookup of the keys of all the values.
+func (kl *KeyedLookup[K, V]) Keys() Lookup[K] {
+	return Collect(maps.Keys(kl.m))
+}
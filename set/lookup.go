@@ -1,5 +1,7 @@
 package set
 
+import "maps"
+
 // Lookup is a lookup map by given key.
 type Lookup[T comparable] map[T]struct{}
 
@@ -26,6 +28,151 @@ func (l Lookup[T]) Clear() {
 	}
 }
 
+// Len returns the number of elements in the lookup.
+func (l Lookup[T]) Len() int {
+	return len(l)
+}
+
+// Union returns a new lookup with the elements that are in l or in other.
+func (l Lookup[T]) Union(other Lookup[T]) Lookup[T] {
+	big, small := l, other
+	if len(big) < len(small) {
+		big, small = small, big
+	}
+
+	res := maps.Clone(big)
+	if res == nil {
+		res = NewLookupCapped[T](len(small))
+	}
+	for k := range small {
+		res.Add(k)
+	}
+	return res
+}
+
+// UnionWith adds all the elements of other into l.
+func (l Lookup[T]) UnionWith(other Lookup[T]) {
+	for k := range other {
+		l.Add(k)
+	}
+}
+
+// Intersection returns a new lookup with the elements that are both in l and in other.
+func (l Lookup[T]) Intersection(other Lookup[T]) Lookup[T] {
+	small, big := l, other
+	if len(small) > len(big) {
+		small, big = big, small
+	}
+
+	res := NewLookupCapped[T](len(small))
+	for k := range small {
+		if big.Has(k) {
+			res.Add(k)
+		}
+	}
+	return res
+}
+
+// IntersectionWith removes from l all the elements that are not in other.
+func (l Lookup[T]) IntersectionWith(other Lookup[T]) {
+	for k := range l {
+		if !other.Has(k) {
+			delete(l, k)
+		}
+	}
+}
+
+// Difference returns a new lookup with the elements of l that are not in other.
+func (l Lookup[T]) Difference(other Lookup[T]) Lookup[T] {
+	res := NewLookupCapped[T](len(l))
+	for k := range l {
+		if !other.Has(k) {
+			res.Add(k)
+		}
+	}
+	return res
+}
+
+// DifferenceWith removes from l all the elements that are in other.
+func (l Lookup[T]) DifferenceWith(other Lookup[T]) {
+	if len(other) < len(l) {
+		for k := range other {
+			delete(l, k)
+		}
+		return
+	}
+
+	for k := range l {
+		if other.Has(k) {
+			delete(l, k)
+		}
+	}
+}
+
+// SymmetricDifference returns a new lookup with the elements that are either in l or in other, but not in both.
+func (l Lookup[T]) SymmetricDifference(other Lookup[T]) Lookup[T] {
+	res := NewLookupCapped[T](len(l) + len(other))
+	for k := range l {
+		if !other.Has(k) {
+			res.Add(k)
+		}
+	}
+	for k := range other {
+		if !l.Has(k) {
+			res.Add(k)
+		}
+	}
+	return res
+}
+
+// SymmetricDifferenceWith makes l contain the elements that were either in l or in other, but not in both.
+func (l Lookup[T]) SymmetricDifferenceWith(other Lookup[T]) {
+	for k := range other {
+		if l.Has(k) {
+			delete(l, k)
+		} else {
+			l.Add(k)
+		}
+	}
+}
+
+// IsSubset returns true if every element of l is in other.
+func (l Lookup[T]) IsSubset(other Lookup[T]) bool {
+	if len(l) > len(other) {
+		return false
+	}
+	for k := range l {
+		if !other.Has(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if every element of other is in l.
+func (l Lookup[T]) IsSuperset(other Lookup[T]) bool {
+	return other.IsSubset(l)
+}
+
+// IsDisjoint returns true if l and other have no elements in common.
+func (l Lookup[T]) IsDisjoint(other Lookup[T]) bool {
+	small, big := l, other
+	if len(small) > len(big) {
+		small, big = big, small
+	}
+	for k := range small {
+		if big.Has(k) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if l and other contain the same elements.
+func (l Lookup[T]) Equal(other Lookup[T]) bool {
+	return len(l) == len(other) && l.IsSubset(other)
+}
+
 // NewLookup returns new ready to use lookup map.
 func NewLookup[T comparable](initialKeys ...T) Lookup[T] {
 	l := make(Lookup[T], len(initialKeys))
@@ -0,0 +1,345 @@
+package set
+
+import (
+	"fmt"
+	"iter"
+	"math/bits"
+)
+
+// Ordered is a set that remembers the insertion order of its elements.
+// Has is O(1) and Delete is amortised O(log n). Add, Index, At and Pop are O(1) while nothing was deleted
+// from the middle; afterwards they are O(log n) until the deleted slots are compacted.
+// Re-adding an existing element keeps its original position.
+// Like Lookup, Ordered is safe for concurrent reads, but not for reads concurrent with writes.
+// The zero value of Ordered is an empty set ready to use.
+type Ordered[T comparable] struct {
+	pos   map[T]int // element → its slot in items
+	items []orderedItem[T]
+	dead  int   // number of deleted slots in items
+	ranks []int // Fenwick tree counting alive slots, kept by Add and Delete while there are deleted slots
+}
+
+// orderedItem is a slot of Ordered: deleted elements are tombstoned and compacted lazily.
+type orderedItem[T comparable] struct {
+	v     T
+	alive bool
+}
+
+// NewOrdered returns new ready to use ordered set with the given initial elements.
+func NewOrdered[T comparable](initial ...T) *Ordered[T] {
+	o := &Ordered[T]{
+		pos:   make(map[T]int, len(initial)),
+		items: make([]orderedItem[T], 0, len(initial)),
+	}
+	for _, v := range initial {
+		o.Add(v)
+	}
+	return o
+}
+
+// Has returns true if the set has the given element.
+func (o *Ordered[T]) Has(v T) bool {
+	_, ok := o.pos[v]
+	return ok
+}
+
+// Add appends an element to the set, unless it's already there.
+func (o *Ordered[T]) Add(v T) {
+	if _, ok := o.pos[v]; ok {
+		return
+	}
+	if o.pos == nil {
+		o.pos = make(map[T]int)
+	}
+	o.pos[v] = len(o.items)
+	o.items = append(o.items, orderedItem[T]{v: v, alive: true})
+
+	if o.ranks != nil {
+		// the new node k covers the slots (k-lowbit(k), k]
+		k := len(o.items)
+		o.ranks = append(o.ranks, 1+o.aliveBefore(k-1)-o.aliveBefore(k-k&-k))
+	}
+}
+
+// Delete deletes an element from the set.
+func (o *Ordered[T]) Delete(v T) {
+	i, ok := o.pos[v]
+	if !ok {
+		return
+	}
+	if o.ranks == nil && i < len(o.items)-1 {
+		// the first tombstone: from now on positions are resolved via the tree, so reads never write
+		o.buildRanks()
+	}
+
+	delete(o.pos, v)
+	o.items[i] = orderedItem[T]{}
+	o.dead++
+	if o.ranks != nil {
+		for k := i + 1; k <= len(o.ranks); k += k & -k {
+			o.ranks[k-1]--
+		}
+	}
+
+	// keep the last slot alive, so Pop stays O(1)
+	for len(o.items) > 0 && !o.items[len(o.items)-1].alive {
+		o.items = o.items[:len(o.items)-1]
+		o.dead--
+	}
+	switch {
+	case o.dead == 0:
+		o.ranks = nil // positions match slots again
+	case o.ranks != nil:
+		o.ranks = o.ranks[:len(o.items)] // a truncated Fenwick tree stays valid
+	}
+
+	if o.dead > len(o.items)/2 {
+		o.compact()
+	}
+}
+
+// Clear removes all the elements from the set.
+func (o *Ordered[T]) Clear() {
+	clear(o.pos)
+	clear(o.items)
+	o.items = o.items[:0]
+	o.dead = 0
+	o.ranks = nil
+}
+
+// Len returns the number of elements in the set.
+func (o *Ordered[T]) Len() int {
+	return len(o.pos)
+}
+
+// All returns an iterator over the elements of the set, in insertion order.
+// The set must not be modified during the iteration.
+func (o *Ordered[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for _, it := range o.items {
+			if it.alive && !yield(it.v) {
+				return
+			}
+		}
+	}
+}
+
+// Slice returns the elements of the set as a new slice, in insertion order.
+func (o *Ordered[T]) Slice() []T {
+	res := make([]T, 0, o.Len())
+	for v := range o.All() {
+		res = append(res, v)
+	}
+	return res
+}
+
+// Index returns the position of the element in insertion order, or -1 if it's not in the set.
+func (o *Ordered[T]) Index(v T) int {
+	i, ok := o.pos[v]
+	if !ok {
+		return -1
+	}
+	if o.dead == 0 {
+		return i
+	}
+	return o.aliveBefore(i)
+}
+
+// At returns the element at the given position in insertion order.
+// It panics if i is out of range, as slice indexing does.
+func (o *Ordered[T]) At(i int) T {
+	if o.dead == 0 {
+		return o.items[i].v
+	}
+	if i < 0 || i >= o.Len() {
+		panic(fmt.Sprintf("index out of range [%d] with length %d", i, o.Len()))
+	}
+
+	// descend the Fenwick tree to the slot holding the (i+1)-th alive element
+	slot, rest := 0, i+1
+	for step := 1 << (bits.Len(uint(len(o.ranks))) - 1); step > 0; step >>= 1 {
+		if next := slot + step; next <= len(o.ranks) && o.ranks[next-1] < rest {
+			slot, rest = next, rest-o.ranks[next-1]
+		}
+	}
+	return o.items[slot].v
+}
+
+// Pop removes and returns the most recently added element.
+// It returns false if the set is empty.
+func (o *Ordered[T]) Pop() (T, bool) {
+	if o.Len() == 0 {
+		var zero T
+		return zero, false
+	}
+
+	v := o.items[len(o.items)-1].v
+	o.Delete(v)
+	return v, true
+}
+
+// Union returns a new set with the elements of o followed by the elements of other that are not in o.
+func (o *Ordered[T]) Union(other *Ordered[T]) *Ordered[T] {
+	res := o.clone(o.Len() + other.Len())
+	res.UnionWith(other)
+	return res
+}
+
+// UnionWith appends the elements of other that are not in o.
+func (o *Ordered[T]) UnionWith(other *Ordered[T]) {
+	for v := range other.All() {
+		o.Add(v)
+	}
+}
+
+// Intersection returns a new set with the elements of o that are in other, in the order of o.
+func (o *Ordered[T]) Intersection(other *Ordered[T]) *Ordered[T] {
+	res := &Ordered[T]{}
+	for v := range o.All() {
+		if other.Has(v) {
+			res.Add(v)
+		}
+	}
+	return res
+}
+
+// IntersectionWith removes from o all the elements that are not in other.
+func (o *Ordered[T]) IntersectionWith(other *Ordered[T]) {
+	o.retain(other.Has)
+}
+
+// Difference returns a new set with the elements of o that are not in other, in the order of o.
+func (o *Ordered[T]) Difference(other *Ordered[T]) *Ordered[T] {
+	res := o.clone(o.Len())
+	res.DifferenceWith(other)
+	return res
+}
+
+// DifferenceWith removes from o all the elements that are in other.
+func (o *Ordered[T]) DifferenceWith(other *Ordered[T]) {
+	o.retain(func(v T) bool { return !other.Has(v) })
+}
+
+// SymmetricDifference returns a new set with the elements of o that are not in other,
+// followed by the elements of other that are not in o.
+func (o *Ordered[T]) SymmetricDifference(other *Ordered[T]) *Ordered[T] {
+	res := o.clone(o.Len() + other.Len())
+	res.SymmetricDifferenceWith(other)
+	return res
+}
+
+// SymmetricDifferenceWith makes o contain the elements that were either in o or in other, but not in both.
+// Elements of other are appended in their order.
+func (o *Ordered[T]) SymmetricDifferenceWith(other *Ordered[T]) {
+	var added []T
+	for v := range other.All() {
+		if !o.Has(v) {
+			added = append(added, v)
+		}
+	}
+
+	o.retain(func(v T) bool { return !other.Has(v) })
+	for _, v := range added {
+		o.Add(v)
+	}
+}
+
+// IsSubset returns true if every element of o is in other.
+func (o *Ordered[T]) IsSubset(other *Ordered[T]) bool {
+	if o.Len() > other.Len() {
+		return false
+	}
+	for v := range o.pos {
+		if !other.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if every element of other is in o.
+func (o *Ordered[T]) IsSuperset(other *Ordered[T]) bool {
+	return other.IsSubset(o)
+}
+
+// IsDisjoint returns true if o and other have no elements in common.
+func (o *Ordered[T]) IsDisjoint(other *Ordered[T]) bool {
+	small, big := o, other
+	if small.Len() > big.Len() {
+		small, big = big, small
+	}
+	for v := range small.pos {
+		if big.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if o and other contain the same elements, regardless of their order.
+func (o *Ordered[T]) Equal(other *Ordered[T]) bool {
+	return o.Len() == other.Len() && o.IsSubset(other)
+}
+
+// clone returns a compacted copy of o with room for n elements.
+func (o *Ordered[T]) clone(n int) *Ordered[T] {
+	res := &Ordered[T]{
+		pos:   make(map[T]int, n),
+		items: make([]orderedItem[T], 0, n),
+	}
+	for v := range o.All() {
+		res.Add(v)
+	}
+	return res
+}
+
+// buildRanks builds the Fenwick tree of alive slots in O(n).
+func (o *Ordered[T]) buildRanks() {
+	o.ranks = make([]int, len(o.items))
+	for i, it := range o.items {
+		if it.alive {
+			o.ranks[i]++
+		}
+		if parent := i + (i+1)&-(i+1); parent < len(o.ranks) {
+			o.ranks[parent] += o.ranks[i]
+		}
+	}
+}
+
+// aliveBefore returns the number of alive slots before the given slot, using the Fenwick tree.
+func (o *Ordered[T]) aliveBefore(slot int) int {
+	n := 0
+	for k := slot; k > 0; k -= k & -k {
+		n += o.ranks[k-1]
+	}
+	return n
+}
+
+// compact drops deleted slots, so positions of items match the insertion order indexes.
+func (o *Ordered[T]) compact() {
+	if o.dead == 0 {
+		return
+	}
+	o.retain(func(T) bool { return true })
+}
+
+// retain keeps only the elements for which keep returns true, compacting the slots.
+func (o *Ordered[T]) retain(keep func(T) bool) {
+	j := 0
+	for _, it := range o.items {
+		if !it.alive {
+			continue
+		}
+		if !keep(it.v) {
+			delete(o.pos, it.v)
+			continue
+		}
+		o.items[j] = it
+		o.pos[it.v] = j
+		j++
+	}
+	clear(o.items[j:])
+	o.items = o.items[:j]
+	o.dead = 0
+	o.ranks = nil
+}
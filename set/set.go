@@ -0,0 +1,103 @@
+package set
+
+import "iter"
+
+// Set is the common interface of all the set implementations of the package:
+// Lookup, Ordered, Sync, Bits, Sorted and KeyedLookup (Bag is a multiset and doesn't implement it).
+type Set[T any] interface {
+	Has(v T) bool
+	Add(v T)
+	Delete(v T)
+	Len() int
+	All() iter.Seq[T]
+	Clear()
+}
+
+var (
+	_ Set[int] = Lookup[int]{}
+	_ Set[int] = (*Ordered[int])(nil)
+	_ Set[int] = (*Sync[int])(nil)
+	_ Set[int] = (*Bits[int])(nil)
+	_ Set[int] = (*Sorted[int])(nil)
+	_ Set[int] = (*KeyedLookup[int, int])(nil)
+)
+
+// Union adds into dst the elements of all the srcs, and returns dst.
+// Sets of different implementations can be mixed, e.g. set.Union(set.NewSorted[int](), lookup, bits).
+func Union[S Set[T], T any](dst S, srcs ...Set[T]) S {
+	for _, src := range srcs {
+		for v := range src.All() {
+			dst.Add(v)
+		}
+	}
+	return dst
+}
+
+// Intersection adds into dst the elements that are both in a and in b, and returns dst.
+// The smaller of a and b is iterated.
+// Elements are only added to dst, so it should be a new empty set rather than a or b.
+func Intersection[S Set[T], T any](dst S, a, b Set[T]) S {
+	if a.Len() > b.Len() {
+		a, b = b, a
+	}
+	for v := range a.All() {
+		if b.Has(v) {
+			dst.Add(v)
+		}
+	}
+	return dst
+}
+
+// Difference adds into dst the elements of a that are not in b, and returns dst.
+// As with Intersection, dst should not be a or b.
+func Difference[S Set[T], T any](dst S, a, b Set[T]) S {
+	for v := range a.All() {
+		if !b.Has(v) {
+			dst.Add(v)
+		}
+	}
+	return dst
+}
+
+// SymmetricDifference adds into dst the elements that are either in a or in b, but not in both, and returns dst.
+// As with Intersection, dst should not be a or b.
+func SymmetricDifference[S Set[T], T any](dst S, a, b Set[T]) S {
+	Difference(dst, a, b)
+	return Difference(dst, b, a)
+}
+
+// IsSubset returns true if every element of a is in b.
+func IsSubset[T any](a, b Set[T]) bool {
+	if a.Len() > b.Len() {
+		return false
+	}
+	for v := range a.All() {
+		if !b.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if every element of b is in a.
+func IsSuperset[T any](a, b Set[T]) bool {
+	return IsSubset(b, a)
+}
+
+// IsDisjoint returns true if a and b have no elements in common. The smaller of a and b is iterated.
+func IsDisjoint[T any](a, b Set[T]) bool {
+	if a.Len() > b.Len() {
+		a, b = b, a
+	}
+	for v := range a.All() {
+		if b.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if a and b contain the same elements.
+func Equal[T any](a, b Set[T]) bool {
+	return a.Len() == b.Len() && IsSubset(a, b)
+}
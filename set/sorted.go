@@ -0,0 +1,418 @@
+package set
+
+import (
+	"cmp"
+	"iter"
+)
+
+// Sorted is a set that keeps its elements in ascending order, backed by an AVL tree.
+// Has, Add and Delete are O(log n); besides the common set API, it answers
+// Min, Max, Floor, Ceiling and Range queries, and iterates in ascending order.
+// The zero value of Sorted is an empty set ready to use.
+type Sorted[T cmp.Ordered] struct {
+	root *sortedNode[T]
+	size int
+}
+
+// sortedNode is a node of the Sorted AVL tree.
+type sortedNode[T cmp.Ordered] struct {
+	v           T
+	left, right *sortedNode[T]
+	height      int
+}
+
+// NewSorted returns new ready to use sorted set with the given initial elements.
+func NewSorted[T cmp.Ordered](initial ...T) *Sorted[T] {
+	s := &Sorted[T]{}
+	for _, v := range initial {
+		s.Add(v)
+	}
+	return s
+}
+
+// Has returns true if the set has the given element.
+func (s *Sorted[T]) Has(v T) bool {
+	n := s.root
+	for n != nil {
+		switch c := cmp.Compare(v, n.v); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			n = n.right
+		default:
+			return true
+		}
+	}
+	return false
+}
+
+// Add adds an element into the set.
+func (s *Sorted[T]) Add(v T) {
+	var added bool
+	s.root, added = s.root.insert(v)
+	if added {
+		s.size++
+	}
+}
+
+// Delete deletes an element from the set.
+func (s *Sorted[T]) Delete(v T) {
+	var deleted bool
+	s.root, deleted = s.root.remove(v)
+	if deleted {
+		s.size--
+	}
+}
+
+// Clear removes all the elements from the set.
+func (s *Sorted[T]) Clear() {
+	s.root, s.size = nil, 0
+}
+
+// Len returns the number of elements in the set.
+func (s *Sorted[T]) Len() int {
+	return s.size
+}
+
+// All returns an iterator over the elements of the set, in ascending order.
+// The set must not be modified during the iteration.
+func (s *Sorted[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.root.walk(nil, nil, yield)
+	}
+}
+
+// Range returns an iterator over the elements in [lo, hi), in ascending order.
+// The set must not be modified during the iteration.
+func (s *Sorted[T]) Range(lo, hi T) iter.Seq[T] {
+	return func(yield func(T) bool) {
+		s.root.walk(&lo, &hi, yield)
+	}
+}
+
+// Slice returns the elements of the set as a new slice, in ascending order.
+func (s *Sorted[T]) Slice() []T {
+	res := make([]T, 0, s.size)
+	for v := range s.All() {
+		res = append(res, v)
+	}
+	return res
+}
+
+// Min returns the smallest element of the set. It returns false if the set is empty.
+func (s *Sorted[T]) Min() (T, bool) {
+	n := s.root
+	if n == nil {
+		var zero T
+		return zero, false
+	}
+	for n.left != nil {
+		n = n.left
+	}
+	return n.v, true
+}
+
+// Max returns the largest element of the set. It returns false if the set is empty.
+func (s *Sorted[T]) Max() (T, bool) {
+	n := s.root
+	if n == nil {
+		var zero T
+		return zero, false
+	}
+	for n.right != nil {
+		n = n.right
+	}
+	return n.v, true
+}
+
+// Floor returns the largest element less than or equal to v. It returns false if there is none.
+func (s *Sorted[T]) Floor(v T) (T, bool) {
+	var res *sortedNode[T]
+	for n := s.root; n != nil; {
+		switch c := cmp.Compare(v, n.v); {
+		case c < 0:
+			n = n.left
+		case c > 0:
+			res, n = n, n.right
+		default:
+			return n.v, true
+		}
+	}
+	return res.value()
+}
+
+// Ceiling returns the smallest element greater than or equal to v. It returns false if there is none.
+func (s *Sorted[T]) Ceiling(v T) (T, bool) {
+	var res *sortedNode[T]
+	for n := s.root; n != nil; {
+		switch c := cmp.Compare(v, n.v); {
+		case c < 0:
+			res, n = n, n.left
+		case c > 0:
+			n = n.right
+		default:
+			return n.v, true
+		}
+	}
+	return res.value()
+}
+
+// Union returns a new set with the elements that are in s or in other.
+func (s *Sorted[T]) Union(other *Sorted[T]) *Sorted[T] {
+	return fromSortedSlice(mergeSorted(s.Slice(), other.Slice(), true, true, true))
+}
+
+// UnionWith adds all the elements of other into s.
+func (s *Sorted[T]) UnionWith(other *Sorted[T]) {
+	*s = *s.Union(other)
+}
+
+// Intersection returns a new set with the elements that are both in s and in other.
+func (s *Sorted[T]) Intersection(other *Sorted[T]) *Sorted[T] {
+	return fromSortedSlice(mergeSorted(s.Slice(), other.Slice(), false, true, false))
+}
+
+// IntersectionWith removes from s all the elements that are not in other.
+func (s *Sorted[T]) IntersectionWith(other *Sorted[T]) {
+	*s = *s.Intersection(other)
+}
+
+// Difference returns a new set with the elements of s that are not in other.
+func (s *Sorted[T]) Difference(other *Sorted[T]) *Sorted[T] {
+	return fromSortedSlice(mergeSorted(s.Slice(), other.Slice(), true, false, false))
+}
+
+// DifferenceWith removes from s all the elements that are in other.
+func (s *Sorted[T]) DifferenceWith(other *Sorted[T]) {
+	*s = *s.Difference(other)
+}
+
+// SymmetricDifference returns a new set with the elements that are either in s or in other, but not in both.
+func (s *Sorted[T]) SymmetricDifference(other *Sorted[T]) *Sorted[T] {
+	return fromSortedSlice(mergeSorted(s.Slice(), other.Slice(), true, false, true))
+}
+
+// SymmetricDifferenceWith makes s contain the elements that were either in s or in other, but not in both.
+func (s *Sorted[T]) SymmetricDifferenceWith(other *Sorted[T]) {
+	*s = *s.SymmetricDifference(other)
+}
+
+// IsSubset returns true if every element of s is in other.
+func (s *Sorted[T]) IsSubset(other *Sorted[T]) bool {
+	if s.size > other.size {
+		return false
+	}
+	for v := range s.All() {
+		if !other.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// IsSuperset returns true if every element of other is in s.
+func (s *Sorted[T]) IsSuperset(other *Sorted[T]) bool {
+	return other.IsSubset(s)
+}
+
+// IsDisjoint returns true if s and other have no elements in common.
+func (s *Sorted[T]) IsDisjoint(other *Sorted[T]) bool {
+	small, big := s, other
+	if small.size > big.size {
+		small, big = big, small
+	}
+	for v := range small.All() {
+		if big.Has(v) {
+			return false
+		}
+	}
+	return true
+}
+
+// Equal returns true if s and other contain the same elements.
+func (s *Sorted[T]) Equal(other *Sorted[T]) bool {
+	return s.size == other.size && s.IsSubset(other)
+}
+
+// mergeSorted merges two ascending slices, keeping elements that are only in a, in both, or only in b.
+func mergeSorted[T cmp.Ordered](a, b []T, onlyA, both, onlyB bool) []T {
+	res := make([]T, 0, len(a)+len(b))
+	i, j := 0, 0
+	for i < len(a) && j < len(b) {
+		switch c := cmp.Compare(a[i], b[j]); {
+		case c < 0:
+			if onlyA {
+				res = append(res, a[i])
+			}
+			i++
+		case c > 0:
+			if onlyB {
+				res = append(res, b[j])
+			}
+			j++
+		default:
+			if both {
+				res = append(res, a[i])
+			}
+			i++
+			j++
+		}
+	}
+	if onlyA {
+		res = append(res, a[i:]...)
+	}
+	if onlyB {
+		res = append(res, b[j:]...)
+	}
+	return res
+}
+
+// fromSortedSlice builds a perfectly balanced set from an ascending slice without duplicates, in O(n).
+func fromSortedSlice[T cmp.Ordered](vals []T) *Sorted[T] {
+	var build func(lo, hi int) *sortedNode[T]
+	build = func(lo, hi int) *sortedNode[T] {
+		if lo >= hi {
+			return nil
+		}
+		mid := int(uint(lo+hi) >> 1)
+		n := &sortedNode[T]{v: vals[mid], left: build(lo, mid), right: build(mid+1, hi)}
+		n.fix()
+		return n
+	}
+	return &Sorted[T]{root: build(0, len(vals)), size: len(vals)}
+}
+
+// value returns the node's element, or false for a nil node.
+func (n *sortedNode[T]) value() (T, bool) {
+	if n == nil {
+		var zero T
+		return zero, false
+	}
+	return n.v, true
+}
+
+// walk yields the elements of the subtree in ascending order, limited to [lo, hi) when bounds are given.
+// It returns false once yield asks to stop.
+func (n *sortedNode[T]) walk(lo, hi *T, yield func(T) bool) bool {
+	if n == nil {
+		return true
+	}
+	aboveLo := lo == nil || cmp.Compare(n.v, *lo) >= 0
+	belowHi := hi == nil || cmp.Compare(n.v, *hi) < 0
+
+	if aboveLo && !n.left.walk(lo, hi, yield) {
+		return false
+	}
+	if aboveLo && belowHi && !yield(n.v) {
+		return false
+	}
+	if belowHi {
+		return n.right.walk(lo, hi, yield)
+	}
+	return true
+}
+
+// insert adds v into the subtree, returning its new root and whether v was added.
+func (n *sortedNode[T]) insert(v T) (*sortedNode[T], bool) {
+	if n == nil {
+		return &sortedNode[T]{v: v, height: 1}, true
+	}
+
+	var added bool
+	switch c := cmp.Compare(v, n.v); {
+	case c < 0:
+		n.left, added = n.left.insert(v)
+	case c > 0:
+		n.right, added = n.right.insert(v)
+	default:
+		return n, false
+	}
+	if !added {
+		return n, false
+	}
+	return n.rebalance(), true
+}
+
+// remove deletes v from the subtree, returning its new root and whether v was deleted.
+func (n *sortedNode[T]) remove(v T) (*sortedNode[T], bool) {
+	if n == nil {
+		return nil, false
+	}
+
+	var deleted bool
+	switch c := cmp.Compare(v, n.v); {
+	case c < 0:
+		n.left, deleted = n.left.remove(v)
+	case c > 0:
+		n.right, deleted = n.right.remove(v)
+	default:
+		if n.left == nil {
+			return n.right, true
+		}
+		if n.right == nil {
+			return n.left, true
+		}
+		// replace with the in-order successor
+		succ := n.right
+		for succ.left != nil {
+			succ = succ.left
+		}
+		n.v = succ.v
+		n.right, _ = n.right.remove(succ.v)
+		deleted = true
+	}
+	if !deleted {
+		return n, false
+	}
+	return n.rebalance(), true
+}
+
+// h returns the height of the subtree (0 for nil).
+func (n *sortedNode[T]) h() int {
+	if n == nil {
+		return 0
+	}
+	return n.height
+}
+
+// fix recomputes the node's height from its children.
+func (n *sortedNode[T]) fix() {
+	n.height = 1 + max(n.left.h(), n.right.h())
+}
+
+// rebalance restores the AVL invariant at n, returning the new root of the subtree.
+func (n *sortedNode[T]) rebalance() *sortedNode[T] {
+	n.fix()
+	switch balance := n.left.h() - n.right.h(); {
+	case balance > 1:
+		if n.left.left.h() < n.left.right.h() {
+			n.left = n.left.rotateLeft()
+		}
+		return n.rotateRight()
+	case balance < -1:
+		if n.right.right.h() < n.right.left.h() {
+			n.right = n.right.rotateRight()
+		}
+		return n.rotateLeft()
+	default:
+		return n
+	}
+}
+
+// rotateLeft rotates the subtree to the left, returning its new root.
+func (n *sortedNode[T]) rotateLeft() *sortedNode[T] {
+	r := n.right
+	n.right, r.left = r.left, n
+	n.fix()
+	r.fix()
+	return r
+}
+
+// rotateRight rotates the subtree to the right, returning its new root.
+func (n *sortedNode[T]) rotateRight() *sortedNode[T] {
+	l := n.left
+	n.left, l.right = l.right, n
+	n.fix()
+	l.fix()
+	return l
+}
@@ -0,0 +1,107 @@
+package set
+
+import (
+	"iter"
+	"maps"
+	"sync"
+)
+
+// Sync is a set that is safe for concurrent use by multiple goroutines.
+// It's a Lookup guarded by a sync.RWMutex, so concurrent readers don't block each other.
+// The zero value of Sync is an empty set ready to use. A Sync must not be copied after first use.
+type Sync[T comparable] struct {
+	mu sync.RWMutex
+	l  Lookup[T]
+}
+
+// NewSync returns new ready to use concurrent set with the given initial elements.
+func NewSync[T comparable](initial ...T) *Sync[T] {
+	return &Sync[T]{l: NewLookup(initial...)}
+}
+
+// Has returns true if the set has the given element.
+func (s *Sync[T]) Has(v T) bool {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.l.Has(v)
+}
+
+// Add adds an element into the set.
+func (s *Sync[T]) Add(v T) {
+	s.AddIfAbsent(v)
+}
+
+// AddIfAbsent atomically adds an element into the set, unless it's already there.
+// It returns true if the element was added.
+func (s *Sync[T]) AddIfAbsent(v T) bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.l.Has(v) {
+		return false
+	}
+	if s.l == nil {
+		s.l = NewLookup[T]()
+	}
+	s.l.Add(v)
+	return true
+}
+
+// LoadOrStore adds an element into the set, unless it's already there, mirroring sync.Map's LoadOrStore.
+// It returns true if the element was already in the set (the opposite of AddIfAbsent).
+func (s *Sync[T]) LoadOrStore(v T) (loaded bool) {
+	return !s.AddIfAbsent(v)
+}
+
+// LoadAndDelete atomically deletes an element from the set, mirroring sync.Map's LoadAndDelete.
+// It returns true if the element was in the set.
+func (s *Sync[T]) LoadAndDelete(v T) (loaded bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.l.Has(v) {
+		return false
+	}
+	s.l.Delete(v)
+	return true
+}
+
+// Delete deletes an element from the set.
+func (s *Sync[T]) Delete(v T) {
+	s.LoadAndDelete(v)
+}
+
+// Clear removes all the elements from the set.
+func (s *Sync[T]) Clear() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.l.Clear()
+}
+
+// Len returns the number of elements in the set.
+func (s *Sync[T]) Len() int {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return len(s.l)
+}
+
+// Snapshot returns a copy of the set's elements as a Lookup, which the caller owns.
+func (s *Sync[T]) Snapshot() Lookup[T] {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	res := maps.Clone(s.l)
+	if res == nil {
+		res = NewLookup[T]()
+	}
+	return res
+}
+
+// All returns an iterator over a snapshot of the set, in no particular order.
+// The lock is not held while iterating, so the set may be modified (even from within the loop).
+func (s *Sync[T]) All() iter.Seq[T] {
+	return func(yield func(T) bool) {
+		for v := range s.Snapshot() {
+			if !yield(v) {
+				return
+			}
+		}
+	}
+}
@@ -8,16 +8,18 @@ module github.com/amberpixels/k1/tests
 go 1.26.0
 
 require (
+	github.com/BurntSushi/toml v1.5.0
 	github.com/amberpixels/k1 v0.1.6
 	github.com/expectto/be v1.0.0-rc.8
+	github.com/google/go-cmp v0.7.0
+	github.com/spf13/pflag v1.0.9
+	go.yaml.in/yaml/v3 v3.0.4
 )
 
 require (
 	github.com/IGLOU-EU/go-wildcard v1.0.3 // indirect
 	github.com/golang-jwt/jwt/v5 v5.3.1 // indirect
-	github.com/google/go-cmp v0.7.0 // indirect
 	github.com/onsi/gomega v1.42.1 // indirect
-	go.yaml.in/yaml/v3 v3.0.4 // indirect
 	golang.org/x/net v0.56.0 // indirect
 	golang.org/x/text v0.38.0 // indirect
 )
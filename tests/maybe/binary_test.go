@@ -0,0 +1,85 @@
+package maybe_test
+
+import (
+	"bytes"
+	"encoding/gob"
+	"testing"
+	"time"
+
+	"github.com/amberpixels/k1/maybe"
+	"github.com/expectto/be"
+)
+
+// binaryRoundTrip marshals opt to binary and unmarshals it back into a fresh Option.
+func binaryRoundTrip[T comparable](t *testing.T, opt maybe.Option[T]) maybe.Option[T] {
+	t.Helper()
+
+	data, err := opt.MarshalBinary()
+	be.Require(t, err).To(be.Succeed())
+
+	var got maybe.Option[T]
+	be.Require(t, got.UnmarshalBinary(data)).To(be.Succeed())
+	return got
+}
+
+func TestBinaryRoundTrip(t *testing.T) {
+	be.Expect(t, binaryRoundTrip(t, maybe.Some(42))).To(be.Eq(maybe.Some(42)))
+	be.Expect(t, binaryRoundTrip(t, maybe.Some(0))).To(be.Eq(maybe.Some(0)))
+	be.Expect(t, binaryRoundTrip(t, maybe.NoneInt())).To(be.Eq(maybe.NoneInt()))
+	be.Expect(t, binaryRoundTrip(t, maybe.Some(""))).To(be.Eq(maybe.Some("")))
+	be.Expect(t, binaryRoundTrip(t, maybe.False())).To(be.Eq(maybe.False()))
+	be.Expect(t, binaryRoundTrip(t, maybe.Some(2.5))).To(be.Eq(maybe.Some(2.5)))
+	be.Expect(t, binaryRoundTrip(t, maybe.Some(time.Minute))).To(be.Eq(maybe.Some(time.Minute)))
+	be.Expect(t, binaryRoundTrip(t, maybe.Some(port(443)))).To(be.Eq(maybe.Some(port(443))))
+	be.Expect(t, binaryRoundTrip(t, maybe.Some(point{X: 1, Y: 2}))).To(be.Eq(maybe.Some(point{X: 1, Y: 2})))
+
+	// time.Time goes through its own encoding.BinaryMarshaler
+	ts := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+	got := binaryRoundTrip(t, maybe.Some(ts))
+	be.Expect(t, got.Unwrap().Equal(ts)).To(be.True())
+}
+
+func TestBinaryLayout(t *testing.T) {
+	data, err := maybe.NoneInt().MarshalBinary()
+	be.Expect(t, err).To(be.Succeed())
+	be.Expect(t, data).To(be.Eq([]byte{0}))
+
+	data, err = maybe.Some(1).MarshalBinary()
+	be.Expect(t, err).To(be.Succeed())
+	be.Expect(t, data[0]).To(be.Eq(byte(1)))
+}
+
+func TestUnmarshalBinaryErrors(t *testing.T) {
+	var opt maybe.Int
+	be.Expect(t, opt.UnmarshalBinary(nil)).To(be.HaveOccurred())
+	be.Expect(t, opt.UnmarshalBinary([]byte{7})).To(be.HaveOccurred())
+	be.Expect(t, opt.UnmarshalBinary([]byte{1, 0xff})).To(be.HaveOccurred())
+
+	var ts maybe.Time
+	be.Expect(t, ts.UnmarshalBinary([]byte{1, 0xff})).To(be.HaveOccurred())
+}
+
+func TestGob(t *testing.T) {
+	type cached struct {
+		Name    maybe.String
+		Port    maybe.Int
+		Debug   maybe.Bool
+		Timeout maybe.Duration
+		Since   maybe.Time
+	}
+
+	in := cached{
+		Name:    maybe.Some("api"),
+		Port:    maybe.NoneInt(),
+		Debug:   maybe.False(),
+		Timeout: maybe.Some(time.Second),
+		Since:   maybe.NoneTime(),
+	}
+
+	var buf bytes.Buffer
+	be.Require(t, gob.NewEncoder(&buf).Encode(in)).To(be.Succeed())
+
+	var out cached
+	be.Require(t, gob.NewDecoder(&buf).Decode(&out)).To(be.Succeed())
+	be.Expect(t, out).To(be.Eq(in))
+}
@@ -0,0 +1,79 @@
+package maybe_test
+
+import (
+	"math"
+	"slices"
+	"sort"
+	"testing"
+
+	"github.com/amberpixels/k1/maybe"
+	"github.com/expectto/be"
+	"github.com/google/go-cmp/cmp"
+)
+
+func TestEqual(t *testing.T) {
+	be.Expect(t, maybe.Some(1).Equal(maybe.Some(1))).To(be.True())
+	be.Expect(t, maybe.Some(1).Equal(maybe.Some(2))).To(be.False())
+	be.Expect(t, maybe.Some(0).Equal(maybe.NoneInt())).To(be.False())
+	be.Expect(t, maybe.NoneInt().Equal(maybe.Some(0))).To(be.False())
+	be.Expect(t, maybe.NoneInt().Equal(maybe.NoneInt())).To(be.True())
+}
+
+func TestGoCmp(t *testing.T) {
+	type record struct {
+		ID    int
+		Owner maybe.String
+	}
+
+	a := []record{{ID: 1, Owner: maybe.Some("alice")}, {ID: 2}}
+	b := []record{{ID: 1, Owner: maybe.Some("alice")}, {ID: 2, Owner: maybe.NoneString()}}
+	be.Expect(t, func() { cmp.Equal(a, b) }).To(be.NotPanic())
+	be.Expect(t, cmp.Equal(a, b)).To(be.True())
+
+	b[1].Owner = maybe.Some("bob")
+	be.Expect(t, cmp.Equal(a, b)).To(be.False())
+	be.Expect(t, cmp.Diff(a, b)).To(be.Not(be.Eq("")))
+}
+
+func TestCompare(t *testing.T) {
+	be.Expect(t, maybe.Compare(maybe.Some(1), maybe.Some(2))).To(be.Eq(-1))
+	be.Expect(t, maybe.Compare(maybe.Some(2), maybe.Some(1))).To(be.Eq(1))
+	be.Expect(t, maybe.Compare(maybe.Some(1), maybe.Some(1))).To(be.Eq(0))
+	be.Expect(t, maybe.Compare(maybe.NoneInt(), maybe.Some(math.MinInt))).To(be.Eq(-1))
+	be.Expect(t, maybe.Compare(maybe.Some(math.MinInt), maybe.NoneInt())).To(be.Eq(1))
+	be.Expect(t, maybe.Compare(maybe.NoneInt(), maybe.NoneInt())).To(be.Eq(0))
+
+	// NaN follows cmp.Compare: it is less than any other float
+	be.Expect(t, maybe.Compare(maybe.Some(math.NaN()), maybe.Some(math.Inf(-1)))).To(be.Eq(-1))
+	be.Expect(t, maybe.Compare(maybe.NoneFloat(), maybe.Some(math.NaN()))).To(be.Eq(-1))
+}
+
+func TestCompareNoneLast(t *testing.T) {
+	be.Expect(t, maybe.CompareNoneLast(maybe.Some(1), maybe.Some(2))).To(be.Eq(-1))
+	be.Expect(t, maybe.CompareNoneLast(maybe.NoneInt(), maybe.Some(1))).To(be.Eq(1))
+	be.Expect(t, maybe.CompareNoneLast(maybe.Some(1), maybe.NoneInt())).To(be.Eq(-1))
+	be.Expect(t, maybe.CompareNoneLast(maybe.NoneInt(), maybe.NoneInt())).To(be.Eq(0))
+}
+
+func TestSortOptions(t *testing.T) {
+	opts := []maybe.String{maybe.Some("b"), maybe.NoneString(), maybe.Some("a"), maybe.NoneString(), maybe.Some("c")}
+
+	sorted := slices.Clone(opts)
+	slices.SortFunc(sorted, maybe.Compare)
+	be.Expect(t, sorted).To(be.Eq([]maybe.String{
+		maybe.NoneString(), maybe.NoneString(), maybe.Some("a"), maybe.Some("b"), maybe.Some("c"),
+	}))
+
+	sorted = slices.Clone(opts)
+	slices.SortFunc(sorted, maybe.CompareNoneLast)
+	be.Expect(t, sorted).To(be.Eq([]maybe.String{
+		maybe.Some("a"), maybe.Some("b"), maybe.Some("c"), maybe.NoneString(), maybe.NoneString(),
+	}))
+
+	sorted = slices.Clone(opts)
+	sort.Slice(sorted, func(i, j int) bool { return maybe.Less(sorted[i], sorted[j]) })
+	be.Expect(t, slices.IsSortedFunc(sorted, maybe.Compare)).To(be.True())
+
+	be.Expect(t, maybe.Less(maybe.NoneInt(), maybe.Some(0))).To(be.True())
+	be.Expect(t, maybe.Less(maybe.Some(0), maybe.Some(0))).To(be.False())
+}
@@ -0,0 +1,194 @@
+package maybe_test
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/amberpixels/k1/maybe"
+	"github.com/expectto/be"
+)
+
+type userPatch struct {
+	Name     maybe.Field[string] `json:"name,omitzero"`
+	Email    maybe.Field[string] `json:"email,omitzero"`
+	Age      maybe.Field[int]    `json:"age,omitzero"`
+	Nickname maybe.Field[string] `json:"nickname,omitzero"`
+}
+
+type user struct {
+	Name     string
+	Email    *string
+	Age      maybe.Int
+	Nickname string
+}
+
+func TestFieldStates(t *testing.T) {
+	absent := maybe.Absent[int]()
+	be.Expect(t, absent.IsAbsent()).To(be.True())
+	be.Expect(t, absent.IsNull()).To(be.False())
+	be.Expect(t, absent.IsValue()).To(be.False())
+	be.Expect(t, absent.IsZero()).To(be.True())
+	be.Expect(t, absent.Option()).To(be.Eq(maybe.NoneInt()))
+	be.Expect(t, func() { absent.Unwrap() }).To(be.Panic())
+
+	null := maybe.Null[int]()
+	be.Expect(t, null.IsAbsent()).To(be.False())
+	be.Expect(t, null.IsNull()).To(be.True())
+	be.Expect(t, null.IsValue()).To(be.False())
+	be.Expect(t, null.IsZero()).To(be.False())
+	be.Expect(t, null.Option()).To(be.Eq(maybe.NoneInt()))
+	be.Expect(t, func() { null.Unwrap() }).To(be.Panic())
+
+	value := maybe.Value(0)
+	be.Expect(t, value.IsAbsent()).To(be.False())
+	be.Expect(t, value.IsNull()).To(be.False())
+	be.Expect(t, value.IsValue()).To(be.True())
+	be.Expect(t, value.IsZero()).To(be.False())
+	be.Expect(t, value.Option()).To(be.Eq(maybe.Some(0)))
+	be.Expect(t, value.Unwrap()).To(be.Eq(0))
+
+	// The zero value is Absent.
+	var zero maybe.Field[string]
+	be.Expect(t, zero.IsAbsent()).To(be.True())
+}
+
+func TestFieldJSONUnmarshalling(t *testing.T) {
+	var p userPatch
+	err := json.Unmarshal([]byte(`{"name":"bob","email":null,"age":0}`), &p)
+	be.Expect(t, err).To(be.Succeed())
+
+	be.Expect(t, p.Name).To(be.Eq(maybe.Value("bob")))
+	be.Expect(t, p.Email).To(be.Eq(maybe.Null[string]()))
+	be.Expect(t, p.Age).To(be.Eq(maybe.Value(0)))
+	be.Expect(t, p.Nickname).To(be.Eq(maybe.Absent[string]()))
+
+	err = json.Unmarshal([]byte(`{"age":"old"}`), &p)
+	be.Expect(t, err).To(be.HaveOccurred())
+}
+
+func TestFieldJSONMarshalling(t *testing.T) {
+	p := userPatch{
+		Name:  maybe.Value("bob"),
+		Email: maybe.Null[string](),
+		Age:   maybe.Value(0),
+	}
+
+	data, err := json.Marshal(p)
+	be.Expect(t, err).To(be.Succeed())
+	be.Expect(t, string(data)).To(be.Eq(`{"name":"bob","email":null,"age":0}`))
+
+	// Without omitzero, Absent is written as null.
+	data, err = json.Marshal(struct{ F maybe.Field[int] }{})
+	be.Expect(t, err).To(be.Succeed())
+	be.Expect(t, string(data)).To(be.Eq(`{"F":null}`))
+}
+
+func TestApplyPatch(t *testing.T) {
+	email := "bob@example.com"
+	newUser := func() user {
+		return user{Name: "bob", Email: &email, Age: maybe.Some(30), Nickname: "b"}
+	}
+
+	t.Run("absent fields are untouched", func(t *testing.T) {
+		u := newUser()
+		be.Expect(t, maybe.ApplyPatch(&u, userPatch{})).To(be.Succeed())
+		be.Expect(t, u).To(be.Eq(newUser()))
+	})
+
+	t.Run("null fields are cleared", func(t *testing.T) {
+		u := newUser()
+		patch := userPatch{
+			Name:  maybe.Null[string](),
+			Email: maybe.Null[string](),
+			Age:   maybe.Null[int](),
+		}
+		be.Expect(t, maybe.ApplyPatch(&u, &patch)).To(be.Succeed())
+
+		be.Expect(t, u.Name).To(be.Eq(""))
+		be.Expect(t, u.Email).To(be.Nil())
+		be.Expect(t, u.Age.None()).To(be.True())
+		be.Expect(t, u.Nickname).To(be.Eq("b"))
+	})
+
+	t.Run("values are set", func(t *testing.T) {
+		u := user{}
+		patch := userPatch{
+			Name:  maybe.Value("alice"),
+			Email: maybe.Value("alice@example.com"),
+			Age:   maybe.Value(0),
+		}
+		be.Expect(t, maybe.ApplyPatch(&u, patch)).To(be.Succeed())
+
+		be.Expect(t, u.Name).To(be.Eq("alice"))
+		be.Expect(t, *u.Email).To(be.Eq("alice@example.com"))
+		be.Expect(t, u.Age).To(be.Eq(maybe.Some(0)))
+	})
+
+	t.Run("patch decoded from JSON", func(t *testing.T) {
+		u := newUser()
+		var patch userPatch
+		be.Require(t, json.Unmarshal([]byte(`{"nickname":"bobby","email":null}`), &patch)).To(be.Succeed())
+		be.Expect(t, maybe.ApplyPatch(&u, patch)).To(be.Succeed())
+
+		be.Expect(t, u.Name).To(be.Eq("bob"))
+		be.Expect(t, u.Email).To(be.Nil())
+		be.Expect(t, u.Age).To(be.Eq(maybe.Some(30)))
+		be.Expect(t, u.Nickname).To(be.Eq("bobby"))
+	})
+
+	t.Run("Field dst fields are copied as is", func(t *testing.T) {
+		var dst userPatch
+		be.Expect(t, maybe.ApplyPatch(&dst, userPatch{Email: maybe.Null[string]()})).To(be.Succeed())
+		be.Expect(t, dst.Email.IsNull()).To(be.True())
+	})
+
+	t.Run("errors", func(t *testing.T) {
+		u := newUser()
+		be.Expect(t, maybe.ApplyPatch(u, userPatch{})).To(be.HaveOccurred())
+		be.Expect(t, maybe.ApplyPatch(&u, 42)).To(be.HaveOccurred())
+		be.Expect(t, maybe.ApplyPatch(&u, (*userPatch)(nil))).To(be.HaveOccurred())
+
+		// missing dst field
+		be.Expect(t, maybe.ApplyPatch(&u, struct{ Phone maybe.Field[string] }{
+			Phone: maybe.Value("555"),
+		})).To(be.HaveOccurred())
+
+		// mismatching dst field type
+		be.Expect(t, maybe.ApplyPatch(&u, struct{ Name maybe.Field[int] }{
+			Name: maybe.Value(1),
+		})).To(be.HaveOccurred())
+	})
+
+	t.Run("promoted fields through embedded pointers", func(t *testing.T) {
+		type inner struct{ Name string }
+		type outer struct {
+			*inner
+			Age int
+		}
+		type Inner struct{ Name string }
+		type Outer struct {
+			*Inner
+			Age int
+		}
+		type namePatch struct{ Name maybe.Field[string] }
+
+		// a nil embedded pointer is allocated for values
+		var o Outer
+		be.Require(t, maybe.ApplyPatch(&o, namePatch{Name: maybe.Value("x")})).To(be.Succeed())
+		be.Expect(t, o.Inner).To(be.Eq(&Inner{Name: "x"}))
+
+		// existing embedded structs are patched in place
+		be.Require(t, maybe.ApplyPatch(&o, namePatch{Name: maybe.Value("y")})).To(be.Succeed())
+		be.Expect(t, o.Name).To(be.Eq("y"))
+
+		// null and absent fields don't allocate anything
+		var empty Outer
+		be.Expect(t, maybe.ApplyPatch(&empty, namePatch{Name: maybe.Null[string]()})).To(be.Succeed())
+		be.Expect(t, maybe.ApplyPatch(&empty, namePatch{})).To(be.Succeed())
+		be.Expect(t, empty.Inner).To(be.Nil())
+
+		// an unexported embedded pointer can't be allocated: an error, not a panic
+		var unexported outer
+		be.Expect(t, maybe.ApplyPatch(&unexported, namePatch{Name: maybe.Value("x")})).To(be.HaveOccurred())
+	})
+}
@@ -0,0 +1,185 @@
+package maybe_test
+
+import (
+	"bytes"
+	"flag"
+	"testing"
+	"time"
+
+	"github.com/amberpixels/k1/maybe"
+	"github.com/expectto/be"
+	"github.com/expectto/be/be_string"
+	"github.com/spf13/pflag"
+)
+
+var (
+	_ flag.Value  = (*maybe.Option[int])(nil)
+	_ pflag.Value = (*maybe.Option[int])(nil)
+	_ flag.Value  = (*maybe.FlagValue[int])(nil)
+	_ pflag.Value = (*maybe.FlagValue[int])(nil)
+)
+
+func TestFlagVar(t *testing.T) {
+	newFlagSet := func() (*flag.FlagSet, *maybe.Bool, *maybe.Int, *maybe.Option[string], *maybe.Option[time.Duration]) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		fs.SetOutput(new(bytes.Buffer))
+
+		var (
+			verbose maybe.Bool
+			workers maybe.Int
+			name    maybe.Option[string]
+			timeout maybe.Option[time.Duration]
+		)
+		maybe.FlagVar(fs, &verbose, "verbose", "verbose output")
+		maybe.FlagVar(fs, &workers, "workers", "number of workers")
+		maybe.FlagVar(fs, &name, "name", "name")
+		maybe.FlagVar(fs, &timeout, "timeout", "timeout")
+		return fs, &verbose, &workers, &name, &timeout
+	}
+
+	t.Run("flags that are not passed stay None", func(t *testing.T) {
+		fs, verbose, workers, name, timeout := newFlagSet()
+		be.Require(t, fs.Parse(nil)).To(be.Succeed())
+
+		be.Expect(t, verbose.None()).To(be.True())
+		be.Expect(t, workers.None()).To(be.True())
+		be.Expect(t, name.None()).To(be.True())
+		be.Expect(t, timeout.None()).To(be.True())
+	})
+
+	t.Run("flags passed with zero values are Some", func(t *testing.T) {
+		fs, verbose, workers, name, timeout := newFlagSet()
+		be.Require(t, fs.Parse([]string{"-verbose=false", "-workers=0", "-name=", "-timeout=0s"})).To(be.Succeed())
+
+		be.Expect(t, verbose.Some(false)).To(be.True())
+		be.Expect(t, workers.Some(0)).To(be.True())
+		be.Expect(t, name.Some("")).To(be.True())
+		be.Expect(t, timeout.Some(0)).To(be.True())
+	})
+
+	t.Run("flags passed with values", func(t *testing.T) {
+		fs, verbose, workers, name, timeout := newFlagSet()
+		be.Require(t, fs.Parse([]string{"-verbose", "-workers", "8", "-name", " spaced ", "-timeout=1m"})).To(be.Succeed())
+
+		be.Expect(t, verbose.Some(true)).To(be.True())
+		be.Expect(t, workers.Some(8)).To(be.True())
+		be.Expect(t, name.Some(" spaced ")).To(be.True())
+		be.Expect(t, timeout.Some(time.Minute)).To(be.True())
+	})
+
+	t.Run("invalid values are rejected", func(t *testing.T) {
+		fs, _, workers, _, _ := newFlagSet()
+		be.Expect(t, fs.Parse([]string{"-workers=many"})).To(be.HaveOccurred())
+		be.Expect(t, workers.None()).To(be.True())
+	})
+
+	t.Run("defaults are shown in usage", func(t *testing.T) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		out := new(bytes.Buffer)
+		fs.SetOutput(out)
+
+		port := maybe.Some(8080)
+		maybe.FlagVar(fs, &port, "port", "listen port")
+		fs.PrintDefaults()
+
+		be.Expect(t, out.String()).To(be_string.ContainingSubstring("(default 8080)"))
+	})
+
+	t.Run("None defaults are not shown in usage", func(t *testing.T) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		out := new(bytes.Buffer)
+		fs.SetOutput(out)
+
+		var port maybe.Int
+		maybe.FlagVar(fs, &port, "port", "listen port")
+		fs.PrintDefaults()
+
+		be.Expect(t, out.String()).To(be.Not(be_string.ContainingSubstring("default")))
+	})
+}
+
+// TestPFlag verifies Options work as pflag values via FlagValue, including pflag's typed getters that parse String.
+func TestPFlag(t *testing.T) {
+	t.Run("typed reads", func(t *testing.T) {
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		var (
+			port    maybe.Int
+			name    maybe.Option[string]
+			timeout maybe.Option[time.Duration]
+		)
+		fs.Var(maybe.NewFlagValue(&port), "port", "listen port")
+		fs.Var(maybe.NewFlagValue(&name), "name", "name")
+		fs.Var(maybe.NewFlagValue(&timeout), "timeout", "timeout")
+		be.Require(t, fs.Parse([]string{"--port=42", "--name", " spaced ", "--timeout=1m30s"})).To(be.Succeed())
+
+		n, err := fs.GetInt("port")
+		be.Expect(t, err).To(be.Succeed())
+		be.Expect(t, n).To(be.Eq(42))
+
+		s, err := fs.GetString("name")
+		be.Expect(t, err).To(be.Succeed())
+		be.Expect(t, s).To(be.Eq(" spaced "))
+
+		d, err := fs.GetDuration("timeout")
+		be.Expect(t, err).To(be.Succeed())
+		be.Expect(t, d).To(be.Eq(90 * time.Second))
+	})
+
+	t.Run("defaults are shown in usage", func(t *testing.T) {
+		fs := pflag.NewFlagSet("test", pflag.ContinueOnError)
+		port := maybe.Some(8080)
+		var host maybe.Option[string]
+		fs.Var(maybe.NewFlagValue(&port), "port", "listen port")
+		fs.Var(maybe.NewFlagValue(&host), "host", "listen host")
+
+		usage := fs.FlagUsages()
+		be.Expect(t, usage).To(be_string.ContainingSubstring("(default 8080)"))
+		be.Expect(t, usage).To(be.Not(be_string.ContainingSubstring("None")))
+	})
+}
+
+// TestFlagValueString verifies FlagValue renders the text form of the Option, which Set accepts back.
+func TestFlagValueString(t *testing.T) {
+	port := maybe.Some(42)
+	be.Expect(t, maybe.NewFlagValue(&port).String()).To(be.Eq("42"))
+
+	timeout := maybe.Some(90 * time.Second)
+	be.Expect(t, maybe.NewFlagValue(&timeout).String()).To(be.Eq("1m30s"))
+
+	// strings are returned as is, as Set takes them
+	name := maybe.Some(" hi ")
+	be.Expect(t, maybe.NewFlagValue(&name).String()).To(be.Eq(" hi "))
+
+	var none maybe.Int
+	be.Expect(t, maybe.NewFlagValue(&none).String()).To(be.Eq(""))
+	be.Expect(t, new(maybe.FlagValue[int]).String()).To(be.Eq(""))
+
+	v := maybe.NewFlagValue(&none)
+	be.Expect(t, v.Set("8080")).To(be.Succeed())
+	be.Expect(t, none).To(be.Eq(maybe.Some(8080)))
+	be.Expect(t, v.Type()).To(be.Eq("int"))
+	be.Expect(t, v.IsBoolFlag()).To(be.False())
+}
+
+func TestFlagValueMethods(t *testing.T) {
+	var b maybe.Bool
+	be.Expect(t, b.IsBoolFlag()).To(be.True())
+	be.Expect(t, b.Type()).To(be.Eq("bool"))
+
+	var i maybe.Int
+	be.Expect(t, i.IsBoolFlag()).To(be.False())
+	be.Expect(t, i.Type()).To(be.Eq("int"))
+	be.Expect(t, i.Set("42")).To(be.Succeed())
+	be.Expect(t, i).To(be.Eq(maybe.Some(42)))
+
+	var p maybe.Option[port]
+	be.Expect(t, p.Type()).To(be.Eq("uint16"))
+
+	var d maybe.Option[time.Duration]
+	be.Expect(t, d.Type()).To(be.Eq("duration"))
+
+	var ts maybe.Option[time.Time]
+	be.Expect(t, ts.Type()).To(be.Eq("time.Time"))
+	be.Expect(t, ts.Set("2020-01-02T03:04:05Z")).To(be.Succeed())
+	be.Expect(t, ts.Some()).To(be.True())
+}
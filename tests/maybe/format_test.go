@@ -0,0 +1,84 @@
+package maybe_test
+
+import (
+	"bytes"
+	"fmt"
+	"log/slog"
+	"testing"
+	"time"
+
+	"github.com/amberpixels/k1/maybe"
+	"github.com/expectto/be"
+)
+
+type point struct {
+	X, Y int
+}
+
+// TestString verifies String renders Some(v) or None (flags use FlagValue for the plain text form).
+func TestString(t *testing.T) {
+	be.Expect(t, maybe.Some(42).String()).To(be.Eq("Some(42)"))
+	be.Expect(t, maybe.NoneInt().String()).To(be.Eq("None"))
+	be.Expect(t, maybe.Some("hi").String()).To(be.Eq("Some(hi)"))
+	be.Expect(t, maybe.Some("").String()).To(be.Eq("Some()"))
+
+	var s fmt.Stringer = maybe.True()
+	be.Expect(t, s.String()).To(be.Eq("Some(true)"))
+}
+
+func TestGoString(t *testing.T) {
+	be.Expect(t, maybe.Some(42).GoString()).To(be.Eq("maybe.Some[int](42)"))
+	be.Expect(t, maybe.NoneInt().GoString()).To(be.Eq("maybe.None[int]()"))
+	be.Expect(t, maybe.Some("hi").GoString()).To(be.Eq(`maybe.Some[string]("hi")`))
+	be.Expect(t, maybe.NoneDuration().GoString()).To(be.Eq("maybe.None[time.Duration]()"))
+	be.Expect(t, maybe.None[any]().GoString()).To(be.Eq("maybe.None[interface {}]()"))
+}
+
+func TestFormat(t *testing.T) {
+	cases := []struct {
+		format string
+		arg    any
+		want   string
+	}{
+		{format: "%v", arg: maybe.Some(42), want: "Some(42)"},
+		{format: "%v", arg: maybe.NoneInt(), want: "None"},
+		{format: "%+v", arg: maybe.Some(point{1, 2}), want: "Some({X:1 Y:2})"},
+		{format: "%v", arg: maybe.Some(point{1, 2}), want: "Some({1 2})"},
+		{format: "%#v", arg: maybe.Some(point{1, 2}), want: "maybe.Some[maybe_test.point](maybe_test.point{X:1, Y:2})"},
+		{format: "%#v", arg: maybe.NoneBool(), want: "maybe.None[bool]()"},
+		{format: "%q", arg: maybe.Some("x"), want: `Some("x")`},
+		{format: "%03d", arg: maybe.Some(7), want: "Some(007)"},
+		{format: "%s", arg: maybe.Some(time.Second), want: "Some(1s)"},
+		{format: "%s", arg: maybe.NoneString(), want: "None"},
+		{format: "%-6v|", arg: maybe.NoneInt(), want: "None  |"},
+		{format: "%6v|", arg: maybe.NoneInt(), want: "  None|"},
+		{format: "%.1f", arg: maybe.NoneFloat(), want: "None"},
+	}
+
+	for _, tc := range cases {
+		be.Expect(t, fmt.Sprintf(tc.format, tc.arg)).To(be.Eq(tc.want))
+	}
+
+	// pointers to Options are formatted the same way
+	opt := maybe.Some(1)
+	be.Expect(t, fmt.Sprintf("%v", &opt)).To(be.Eq("Some(1)"))
+
+	// as struct fields too
+	cfg := struct{ Port maybe.Int }{Port: maybe.Some(80)}
+	be.Expect(t, fmt.Sprintf("%+v", cfg)).To(be.Eq("{Port:Some(80)}"))
+}
+
+func TestLogValue(t *testing.T) {
+	var buf bytes.Buffer
+	logger := slog.New(slog.NewJSONHandler(&buf, &slog.HandlerOptions{
+		ReplaceAttr: func(_ []string, a slog.Attr) slog.Attr {
+			if a.Key == slog.TimeKey || a.Key == slog.LevelKey {
+				return slog.Attr{}
+			}
+			return a
+		},
+	}))
+
+	logger.Info("cfg", "port", maybe.Some(8080), "name", maybe.Some("api"), "debug", maybe.NoneBool())
+	be.Expect(t, buf.String()).To(be.Eq(`{"msg":"cfg","port":8080,"name":"api","debug":null}` + "\n"))
+}
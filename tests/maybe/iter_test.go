@@ -0,0 +1,66 @@
+package maybe_test
+
+import (
+	"maps"
+	"slices"
+	"testing"
+
+	"github.com/amberpixels/k1/maybe"
+	"github.com/expectto/be"
+)
+
+func TestAll(t *testing.T) {
+	be.Expect(t, slices.Collect(maybe.Some(42).All())).To(be.Eq([]int{42}))
+	be.Expect(t, slices.Collect(maybe.NoneInt().All())).To(be.HaveLength(0))
+
+	var got []string
+	for v := range maybe.Some("hello").All() {
+		got = append(got, v)
+	}
+	be.Expect(t, got).To(be.Eq([]string{"hello"}))
+}
+
+func TestCollect(t *testing.T) {
+	t.Run("all Some", func(t *testing.T) {
+		vals, ok := maybe.Collect(slices.Values([]maybe.Int{maybe.Some(1), maybe.Some(2), maybe.Some(3)}))
+		be.Expect(t, ok).To(be.True())
+		be.Expect(t, vals).To(be.Eq([]int{1, 2, 3}))
+	})
+
+	t.Run("any None", func(t *testing.T) {
+		vals, ok := maybe.Collect(slices.Values([]maybe.Int{maybe.Some(1), maybe.NoneInt(), maybe.Some(3)}))
+		be.Expect(t, ok).To(be.False())
+		be.Expect(t, vals).To(be.Nil())
+	})
+
+	t.Run("empty", func(t *testing.T) {
+		vals, ok := maybe.Collect(slices.Values([]maybe.Int{}))
+		be.Expect(t, ok).To(be.True())
+		be.Expect(t, vals).To(be.Eq([]int{}))
+	})
+}
+
+func TestValues(t *testing.T) {
+	opts := []maybe.Int{maybe.NoneInt(), maybe.Some(1), maybe.NoneInt(), maybe.Some(2)}
+	be.Expect(t, slices.Collect(maybe.Values(slices.Values(opts)))).To(be.Eq([]int{1, 2}))
+
+	// early break is respected
+	var first []int
+	for v := range maybe.Values(slices.Values(opts)) {
+		first = append(first, v)
+		break
+	}
+	be.Expect(t, first).To(be.Eq([]int{1}))
+
+	// composes with maps
+	overrides := map[string]maybe.String{"a": maybe.Some("x"), "b": maybe.NoneString()}
+	be.Expect(t, slices.Collect(maybe.Values(maps.Values(overrides)))).To(be.Eq([]string{"x"}))
+}
+
+func TestFirst(t *testing.T) {
+	opts := []maybe.Int{maybe.NoneInt(), maybe.Some(0), maybe.Some(2)}
+	be.Expect(t, maybe.First(slices.Values(opts))).To(be.Eq(maybe.Some(0)))
+
+	be.Expect(t, maybe.First(slices.Values([]maybe.Int{maybe.NoneInt()}))).To(be.Eq(maybe.NoneInt()))
+	be.Expect(t, maybe.First(slices.Values([]maybe.Int{}))).To(be.Eq(maybe.NoneInt()))
+}
@@ -0,0 +1,94 @@
+package maybe_test
+
+import (
+	"encoding/json"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/amberpixels/k1/maybe"
+	"github.com/expectto/be"
+)
+
+func TestLazyEvaluatesOnce(t *testing.T) {
+	var calls atomic.Int32
+	l := maybe.NewLazy(func() maybe.Int {
+		calls.Add(1)
+		return maybe.Some(42)
+	})
+	be.Expect(t, calls.Load()).To(be.Eq(int32(0)))
+
+	be.Expect(t, l.Some()).To(be.True())
+	be.Expect(t, l.Some(42)).To(be.True())
+	be.Expect(t, l.None()).To(be.False())
+	be.Expect(t, l.Unwrap()).To(be.Eq(42))
+	be.Expect(t, l.UnwrapOr(1)).To(be.Eq(42))
+	be.Expect(t, l.Get()).To(be.Eq(maybe.Some(42)))
+	be.Expect(t, calls.Load()).To(be.Eq(int32(1)))
+}
+
+func TestLazyNone(t *testing.T) {
+	l := maybe.NewLazy(maybe.NoneString)
+	be.Expect(t, l.None()).To(be.True())
+	be.Expect(t, l.Some()).To(be.False())
+	be.Expect(t, l.UnwrapOr("default")).To(be.Eq("default"))
+	be.Expect(t, func() { l.Unwrap() }).To(be.Panic())
+
+	// the zero value is None
+	var zero maybe.Lazy[string]
+	be.Expect(t, zero.None()).To(be.True())
+	be.Expect(t, zero.Get()).To(be.Eq(maybe.NoneString()))
+}
+
+func TestLazyPanicsConsistently(t *testing.T) {
+	l := maybe.NewLazy(func() maybe.Int { panic("boom") })
+	be.Expect(t, func() { l.Get() }).To(be.Panic())
+	be.Expect(t, func() { l.Get() }).To(be.Panic())
+}
+
+func TestLazyConcurrentReaders(t *testing.T) {
+	var calls atomic.Int32
+	l := maybe.NewLazy(func() maybe.Int {
+		calls.Add(1)
+		return maybe.Some(7)
+	})
+
+	var wg sync.WaitGroup
+	results := make([]int, 64)
+	for i := range results {
+		wg.Go(func() {
+			results[i] = l.Unwrap()
+		})
+	}
+	wg.Wait()
+
+	be.Expect(t, calls.Load()).To(be.Eq(int32(1)))
+	for _, r := range results {
+		be.Expect(t, r).To(be.Eq(7))
+	}
+}
+
+func TestLazyJSON(t *testing.T) {
+	type config struct {
+		Port *maybe.Lazy[int]    `json:"port"`
+		Name *maybe.Lazy[string] `json:"name"`
+	}
+
+	cfg := config{
+		Port: maybe.NewLazy(func() maybe.Int { return maybe.Some(8080) }),
+		Name: maybe.NewLazy(maybe.NoneString),
+	}
+	data, err := json.Marshal(cfg)
+	be.Expect(t, err).To(be.Succeed())
+	be.Expect(t, string(data)).To(be.Eq(`{"port":8080,"name":null}`))
+
+	var back config
+	be.Expect(t, json.Unmarshal(data, &back)).To(be.Succeed())
+	be.Expect(t, back.Port.Get()).To(be.Eq(maybe.Some(8080)))
+	be.Expect(t, back.Name).To(be.Nil()) // encoding/json sets pointers to nil for null
+	be.Expect(t, back.Name.Get()).To(be.Eq(maybe.NoneString()))
+	be.Expect(t, back.Name.None()).To(be.True())
+
+	var bad maybe.Lazy[int]
+	be.Expect(t, bad.UnmarshalJSON([]byte(`"x"`))).To(be.HaveOccurred())
+}
@@ -0,0 +1,250 @@
+package maybe_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/amberpixels/k1/maybe"
+	"github.com/expectto/be"
+)
+
+type serverConfig struct {
+	Host maybe.String
+	Port maybe.Int
+}
+
+type appConfig struct {
+	Server  serverConfig
+	TLS     *serverConfig
+	Debug   maybe.Bool
+	Timeout maybe.Duration
+	Name    string // not an Option: never merged
+
+	secret maybe.String
+}
+
+func TestMergeFillsNone(t *testing.T) {
+	dst := appConfig{
+		Server: serverConfig{Port: maybe.Some(9000)},
+		Name:   "dst",
+	}
+	src := appConfig{
+		Server:  serverConfig{Host: maybe.Some("localhost"), Port: maybe.Some(8080)},
+		Debug:   maybe.False(),
+		Timeout: maybe.NoneDuration(),
+		Name:    "src",
+		secret:  maybe.Some("s3cr3t"),
+	}
+
+	set, err := maybe.Merge(&dst, src)
+	be.Expect(t, err).To(be.Succeed())
+	be.Expect(t, set).To(be.Eq([]string{"Server.Host", "Debug"}))
+
+	be.Expect(t, dst.Server.Host).To(be.Eq(maybe.Some("localhost")))
+	be.Expect(t, dst.Server.Port).To(be.Eq(maybe.Some(9000))) // kept: dst was Some
+	be.Expect(t, dst.Debug).To(be.Eq(maybe.False()))
+	be.Expect(t, dst.Timeout.None()).To(be.True())
+	be.Expect(t, dst.Name).To(be.Eq("dst"))
+	be.Expect(t, dst.secret.None()).To(be.True())
+}
+
+func TestMergeOverride(t *testing.T) {
+	dst := appConfig{
+		Server: serverConfig{Host: maybe.Some("example.com"), Port: maybe.Some(9000)},
+		Debug:  maybe.True(),
+	}
+	src := &appConfig{
+		Server: serverConfig{Port: maybe.Some(8080)},
+		Debug:  maybe.NoneBool(),
+	}
+
+	set, err := maybe.Merge(&dst, src, maybe.Override())
+	be.Expect(t, err).To(be.Succeed())
+	be.Expect(t, set).To(be.Eq([]string{"Server.Port"}))
+
+	be.Expect(t, dst.Server.Host).To(be.Eq(maybe.Some("example.com")))
+	be.Expect(t, dst.Server.Port).To(be.Eq(maybe.Some(8080)))
+	be.Expect(t, dst.Debug).To(be.Eq(maybe.True())) // None in src never overrides
+}
+
+func TestMergeNestedPointers(t *testing.T) {
+	t.Run("nil dst pointer is allocated", func(t *testing.T) {
+		var dst appConfig
+		src := appConfig{TLS: &serverConfig{Port: maybe.Some(443)}}
+
+		set, err := maybe.Merge(&dst, src)
+		be.Expect(t, err).To(be.Succeed())
+		be.Expect(t, set).To(be.Eq([]string{"TLS.Port"}))
+		be.Expect(t, dst.TLS.Port).To(be.Eq(maybe.Some(443)))
+
+		// src is not aliased
+		src.TLS.Port = maybe.Some(8443)
+		be.Expect(t, dst.TLS.Port).To(be.Eq(maybe.Some(443)))
+	})
+
+	t.Run("nothing to merge leaves nil pointer", func(t *testing.T) {
+		var dst appConfig
+		_, err := maybe.Merge(&dst, appConfig{TLS: &serverConfig{}})
+		be.Expect(t, err).To(be.Succeed())
+		be.Expect(t, dst.TLS).To(be.Nil())
+	})
+}
+
+// TestMergeOptionPointers verifies *Option fields are merged as Options (nil being None), not walked as structs.
+func TestMergeOptionPointers(t *testing.T) {
+	type config struct {
+		Port    *maybe.Int
+		Host    *maybe.String
+		Verbose *maybe.Bool
+	}
+	somePtr := func(v int) *maybe.Int {
+		o := maybe.Some(v)
+		return &o
+	}
+
+	t.Run("fills nil and None pointers", func(t *testing.T) {
+		dstHost, srcHost, srcVerbose := maybe.NoneString(), maybe.Some("localhost"), maybe.NoneBool()
+		dst := config{Host: &dstHost}
+		src := config{Port: somePtr(8080), Host: &srcHost, Verbose: &srcVerbose}
+
+		set, err := maybe.Merge(&dst, src)
+		be.Expect(t, err).To(be.Succeed())
+		be.Expect(t, set).To(be.Eq([]string{"Port", "Host"}))
+		be.Expect(t, *dst.Port).To(be.Eq(maybe.Some(8080)))
+		be.Expect(t, *dst.Host).To(be.Eq(maybe.Some("localhost")))
+		be.Expect(t, dst.Verbose).To(be.Nil()) // None in src is not merged
+
+		// src is not aliased
+		*src.Port = maybe.Some(9090)
+		be.Expect(t, *dst.Port).To(be.Eq(maybe.Some(8080)))
+	})
+
+	t.Run("keeps Some pointers unless overriding", func(t *testing.T) {
+		dst := config{Port: somePtr(9000)}
+		src := config{Port: somePtr(8080)}
+
+		set, err := maybe.Merge(&dst, src)
+		be.Expect(t, err).To(be.Succeed())
+		be.Expect(t, set).To(be.HaveLength(0))
+		be.Expect(t, *dst.Port).To(be.Eq(maybe.Some(9000)))
+
+		set, err = maybe.Merge(&dst, src, maybe.Override())
+		be.Expect(t, err).To(be.Succeed())
+		be.Expect(t, set).To(be.Eq([]string{"Port"}))
+		be.Expect(t, *dst.Port).To(be.Eq(maybe.Some(8080)))
+	})
+}
+
+// TestMergeCycles verifies Merge fails on src pointer cycles instead of recursing forever,
+// while shared (but acyclic) pointers are still merged.
+func TestMergeCycles(t *testing.T) {
+	type node struct {
+		Name maybe.String
+		Next *node
+	}
+
+	t.Run("self reference", func(t *testing.T) {
+		src := &node{Name: maybe.Some("a")}
+		src.Next = src
+
+		var dst node
+		_, err := maybe.Merge(&dst, src)
+		be.Expect(t, err).To(be.HaveOccurred())
+	})
+
+	t.Run("longer cycle", func(t *testing.T) {
+		a, b := &node{Name: maybe.Some("a")}, &node{Name: maybe.Some("b")}
+		a.Next, b.Next = b, a
+
+		var dst node
+		_, err := maybe.Merge(&dst, node{Next: a})
+		be.Expect(t, err).To(be.HaveOccurred())
+	})
+
+	t.Run("shared pointers are not cycles", func(t *testing.T) {
+		type pair struct {
+			Left, Right *serverConfig
+		}
+		shared := &serverConfig{Port: maybe.Some(80)}
+
+		var dst pair
+		set, err := maybe.Merge(&dst, pair{Left: shared, Right: shared})
+		be.Expect(t, err).To(be.Succeed())
+		be.Expect(t, set).To(be.Eq([]string{"Left.Port", "Right.Port"}))
+	})
+}
+
+func TestMergeErrors(t *testing.T) {
+	var dst appConfig
+	_, err := maybe.Merge(dst, appConfig{})
+	be.Expect(t, err).To(be.HaveOccurred())
+
+	_, err = maybe.Merge(&dst, serverConfig{})
+	be.Expect(t, err).To(be.HaveOccurred())
+
+	_, err = maybe.Merge(&dst, nil)
+	be.Expect(t, err).To(be.HaveOccurred())
+}
+
+func TestMergeLayers(t *testing.T) {
+	defaults := appConfig{
+		Server:  serverConfig{Host: maybe.Some("0.0.0.0"), Port: maybe.Some(80)},
+		Debug:   maybe.False(),
+		Timeout: maybe.Some(30 * time.Second),
+	}
+	file := appConfig{Server: serverConfig{Port: maybe.Some(8080)}}
+	env := appConfig{Debug: maybe.True()}
+	flags := appConfig{Server: serverConfig{Port: maybe.Some(9090)}}
+
+	t.Run("override from lowest to highest priority", func(t *testing.T) {
+		var cfg appConfig
+		report, err := maybe.MergeLayers(&cfg, []maybe.Layer{
+			{Name: "defaults", Value: defaults},
+			{Name: "file", Value: file},
+			{Name: "env", Value: env},
+			{Name: "flags", Value: flags},
+		}, maybe.Override())
+		be.Expect(t, err).To(be.Succeed())
+
+		be.Expect(t, cfg.Server.Host).To(be.Eq(maybe.Some("0.0.0.0")))
+		be.Expect(t, cfg.Server.Port).To(be.Eq(maybe.Some(9090)))
+		be.Expect(t, cfg.Debug).To(be.Eq(maybe.True()))
+		be.Expect(t, cfg.Timeout).To(be.Eq(maybe.Some(30 * time.Second)))
+
+		be.Expect(t, report).To(be.Eq(maybe.MergeReport{
+			"Server.Host": "defaults",
+			"Server.Port": "flags",
+			"Debug":       "env",
+			"Timeout":     "defaults",
+		}))
+	})
+
+	t.Run("fill from highest to lowest priority", func(t *testing.T) {
+		var cfg appConfig
+		report, err := maybe.MergeLayers(&cfg, []maybe.Layer{
+			{Name: "flags", Value: flags},
+			{Name: "env", Value: env},
+			{Name: "file", Value: file},
+			{Name: "defaults", Value: defaults},
+		})
+		be.Expect(t, err).To(be.Succeed())
+
+		be.Expect(t, cfg.Server.Port).To(be.Eq(maybe.Some(9090)))
+		be.Expect(t, report).To(be.Eq(maybe.MergeReport{
+			"Server.Host": "defaults",
+			"Server.Port": "flags",
+			"Debug":       "env",
+			"Timeout":     "defaults",
+		}))
+	})
+
+	t.Run("invalid layers are reported", func(t *testing.T) {
+		var cfg appConfig
+		report, err := maybe.MergeLayers(&cfg, []maybe.Layer{
+			{Name: "broken", Value: 42},
+			{Name: "env", Value: env},
+		})
+		be.Expect(t, err).To(be.HaveOccurred())
+		be.Expect(t, report).To(be.Eq(maybe.MergeReport{"Debug": "env"}))
+	})
+}
@@ -1,7 +1,9 @@
 package maybe_test
 
 import (
+	"encoding"
 	"encoding/json"
+	"math"
 	"testing"
 	"time"
 
@@ -36,6 +38,83 @@ func TestUnwrap(t *testing.T) {
 	be.Expect(t, func() { optNone.Unwrap() }).To(be.Panic())
 }
 
+func TestUnwrapOr(t *testing.T) {
+	optSome := maybe.Some(5)
+	be.Expect(t, optSome.UnwrapOr(10)).To(be.Eq(5))
+
+	optNone := maybe.NoneInt()
+	be.Expect(t, optNone.UnwrapOr(10)).To(be.Eq(10))
+}
+
+func TestInsert(t *testing.T) {
+	var opt maybe.Int
+	p := opt.Insert(5)
+	be.Expect(t, opt).To(be.Eq(maybe.Some(5)))
+
+	*p = 6
+	be.Expect(t, opt).To(be.Eq(maybe.Some(6)))
+}
+
+func TestClear(t *testing.T) {
+	opt := maybe.Some(5)
+	opt.Clear()
+	be.Expect(t, opt.None()).To(be.True())
+
+	// clearing None is a no-op
+	opt.Clear()
+	be.Expect(t, opt.None()).To(be.True())
+}
+
+func TestTake(t *testing.T) {
+	opt := maybe.Some("x")
+	be.Expect(t, opt.Take()).To(be.Eq(maybe.Some("x")))
+	be.Expect(t, opt.None()).To(be.True())
+
+	be.Expect(t, opt.Take()).To(be.Eq(maybe.NoneString()))
+	be.Expect(t, opt.None()).To(be.True())
+}
+
+func TestReplace(t *testing.T) {
+	var opt maybe.Int
+	be.Expect(t, opt.Replace(1)).To(be.Eq(maybe.NoneInt()))
+	be.Expect(t, opt).To(be.Eq(maybe.Some(1)))
+
+	be.Expect(t, opt.Replace(2)).To(be.Eq(maybe.Some(1)))
+	be.Expect(t, opt).To(be.Eq(maybe.Some(2)))
+}
+
+func TestGetOrInsert(t *testing.T) {
+	var opt maybe.Int
+	p := opt.GetOrInsert(1)
+	be.Expect(t, *p).To(be.Eq(1))
+	be.Expect(t, opt).To(be.Eq(maybe.Some(1)))
+
+	// existing value is kept
+	p = opt.GetOrInsert(2)
+	be.Expect(t, *p).To(be.Eq(1))
+
+	*p = 3
+	be.Expect(t, opt).To(be.Eq(maybe.Some(3)))
+}
+
+func TestGetOrInsertWith(t *testing.T) {
+	calls := 0
+	f := func() int {
+		calls++
+		return 10
+	}
+
+	var opt maybe.Int
+	be.Expect(t, *opt.GetOrInsertWith(f)).To(be.Eq(10))
+	be.Expect(t, *opt.GetOrInsertWith(f)).To(be.Eq(10))
+	be.Expect(t, calls).To(be.Eq(1))
+
+	// lazily-initialised config field
+	cfg := struct{ Workers maybe.Int }{}
+	*cfg.Workers.GetOrInsertWith(func() int { return 4 }) *= 2
+	be.Expect(t, cfg.Workers).To(be.Eq(maybe.Some(8)))
+}
+
 func TestJSONMarshalling(t *testing.T) {
 	optSome := maybe.Some(100)
 	marshalledSome, err := json.Marshal(optSome)
@@ -143,27 +222,218 @@ func TestUnmarshalTextScalarJSONError(t *testing.T) {
 }
 
 func TestUnmarshalTextUnsupportedType(t *testing.T) {
-	// int is comparable, not a TextUnmarshaler, and not in the scalar switch
-	// (only float64/string/bool are) -> the final "no fallback" error.
-	var opt maybe.Option[int]
+	// A struct is comparable, not a TextUnmarshaler, and not a scalar kind
+	// -> the final "no fallback" error.
+	var opt maybe.Option[struct{ A int }]
 	err := opt.UnmarshalText([]byte("5"))
 	be.Expect(t, err).To(be.HaveOccurred())
 }
 
+// Custom scalar types are parsed via their reflect.Kind.
+type (
+	port     uint16
+	label    string
+	ratio    float32
+	severity int8
+	enabled  bool
+)
+
+func TestUnmarshalTextScalarKinds(t *testing.T) {
+	t.Run("signed integers", func(t *testing.T) {
+		var i maybe.Int
+		be.Expect(t, i.UnmarshalText([]byte("5"))).To(be.Succeed())
+		be.Expect(t, i.Some(5)).To(be.True())
+
+		var i8 maybe.Option[int8]
+		be.Expect(t, i8.UnmarshalText([]byte("-128"))).To(be.Succeed())
+		be.Expect(t, i8.Some(-128)).To(be.True())
+
+		var i64 maybe.Option[int64]
+		be.Expect(t, i64.UnmarshalText([]byte(" 9223372036854775807 "))).To(be.Succeed())
+		be.Expect(t, i64.Some(9223372036854775807)).To(be.True())
+	})
+
+	t.Run("unsigned integers", func(t *testing.T) {
+		var u maybe.Option[uint]
+		be.Expect(t, u.UnmarshalText([]byte("42"))).To(be.Succeed())
+		be.Expect(t, u.Some(42)).To(be.True())
+
+		var u64 maybe.Option[uint64]
+		be.Expect(t, u64.UnmarshalText([]byte("18446744073709551615"))).To(be.Succeed())
+		be.Expect(t, u64.Some(18446744073709551615)).To(be.True())
+	})
+
+	t.Run("integral floats are accepted for integers", func(t *testing.T) {
+		var i maybe.Int
+		be.Expect(t, i.UnmarshalText([]byte("42.0"))).To(be.Succeed())
+		be.Expect(t, i.Some(42)).To(be.True())
+
+		var u maybe.Option[uint32]
+		be.Expect(t, u.UnmarshalText([]byte("1e3"))).To(be.Succeed())
+		be.Expect(t, u.Some(1000)).To(be.True())
+	})
+
+	t.Run("integral floats are range-checked in float64", func(t *testing.T) {
+		var i64 maybe.Option[int64]
+		be.Expect(t, i64.UnmarshalText([]byte("1e10"))).To(be.Succeed())
+		be.Expect(t, i64.Some(10_000_000_000)).To(be.True())
+		be.Expect(t, i64.UnmarshalText([]byte("-9.223372036854775808e18"))).To(be.Succeed())
+		be.Expect(t, i64.Some(math.MinInt64)).To(be.True())
+
+		// 2^63 doesn't fit, it must not saturate into MaxInt64
+		be.Expect(t, i64.UnmarshalText([]byte("9223372036854775808.0"))).To(be.HaveOccurred())
+		be.Expect(t, i64.UnmarshalText([]byte("1.5"))).To(be.HaveOccurred())
+
+		var u64 maybe.Option[uint64]
+		be.Expect(t, u64.UnmarshalText([]byte("1e19"))).To(be.Succeed())
+		be.Expect(t, u64.Some(10_000_000_000_000_000_000)).To(be.True())
+		be.Expect(t, u64.UnmarshalText([]byte("1.8446744073709551616e19"))).To(be.HaveOccurred())
+		be.Expect(t, u64.UnmarshalText([]byte("-1e3"))).To(be.HaveOccurred())
+	})
+
+	t.Run("float32", func(t *testing.T) {
+		var f maybe.Option[float32]
+		be.Expect(t, f.UnmarshalText([]byte("1.5"))).To(be.Succeed())
+		be.Expect(t, f.Some(1.5)).To(be.True())
+	})
+
+	t.Run("unquoted strings", func(t *testing.T) {
+		var s maybe.Option[string]
+		be.Expect(t, s.UnmarshalText([]byte("hello world"))).To(be.Succeed())
+		be.Expect(t, s.Some("hello world")).To(be.True())
+	})
+
+	t.Run("bool accepts strconv forms", func(t *testing.T) {
+		for in, want := range map[string]bool{"1": true, "t": true, "TRUE": true, "0": false, "F": false} {
+			var b maybe.Bool
+			be.Expect(t, b.UnmarshalText([]byte(in))).To(be.Succeed())
+			be.Expect(t, b.Some(want)).To(be.True())
+		}
+	})
+
+	t.Run("custom types", func(t *testing.T) {
+		var p maybe.Option[port]
+		be.Expect(t, p.UnmarshalText([]byte("8080"))).To(be.Succeed())
+		be.Expect(t, p.Some(8080)).To(be.True())
+
+		var l maybe.Option[label]
+		be.Expect(t, l.UnmarshalText([]byte("prod"))).To(be.Succeed())
+		be.Expect(t, l.Some("prod")).To(be.True())
+
+		var r maybe.Option[ratio]
+		be.Expect(t, r.UnmarshalText([]byte("0.25"))).To(be.Succeed())
+		be.Expect(t, r.Some(0.25)).To(be.True())
+
+		var sev maybe.Option[severity]
+		be.Expect(t, sev.UnmarshalText([]byte("-3"))).To(be.Succeed())
+		be.Expect(t, sev.Some(-3)).To(be.True())
+
+		var e maybe.Option[enabled]
+		be.Expect(t, e.UnmarshalText([]byte("true"))).To(be.Succeed())
+		be.Expect(t, e.Some(true)).To(be.True())
+	})
+
+	t.Run("time.Duration", func(t *testing.T) {
+		var d maybe.Option[time.Duration]
+		be.Expect(t, d.UnmarshalText([]byte("1m30s"))).To(be.Succeed())
+		be.Expect(t, d.Some(90*time.Second)).To(be.True())
+
+		be.Expect(t, d.UnmarshalText([]byte("90"))).To(be.HaveOccurred())
+	})
+}
+
+func TestUnmarshalTextScalarErrors(t *testing.T) {
+	var i maybe.Int
+	be.Expect(t, i.UnmarshalText([]byte("abc"))).To(be.HaveOccurred())
+	be.Expect(t, i.UnmarshalText([]byte("4.5"))).To(be.HaveOccurred())
+
+	var i8 maybe.Option[int8]
+	be.Expect(t, i8.UnmarshalText([]byte("128"))).To(be.HaveOccurred())
+
+	var u maybe.Option[uint]
+	be.Expect(t, u.UnmarshalText([]byte("-1"))).To(be.HaveOccurred())
+
+	var p maybe.Option[port]
+	be.Expect(t, p.UnmarshalText([]byte("70000"))).To(be.HaveOccurred())
+
+	var b maybe.Bool
+	be.Expect(t, b.UnmarshalText([]byte("yes"))).To(be.HaveOccurred())
+}
+
 func TestIsZero(t *testing.T) {
 	// None is zero.
 	optNone := maybe.None[int]()
 	be.Expect(t, optNone.IsZero()).To(be.True())
 
-	// Some(zero value) is also zero.
+	// Some(zero value) is a deliberately set value, so it is not zero.
 	optZero := maybe.Some(0)
-	be.Expect(t, optZero.IsZero()).To(be.True())
+	be.Expect(t, optZero.IsZero()).To(be.False())
 
 	// Some(non-zero) is not zero.
 	optSome := maybe.Some(42)
 	be.Expect(t, optSome.IsZero()).To(be.False())
 }
 
+func TestIsNoneOrZero(t *testing.T) {
+	optNone := maybe.None[int]()
+	be.Expect(t, optNone.IsNoneOrZero()).To(be.True())
+
+	optZero := maybe.Some(0)
+	be.Expect(t, optZero.IsNoneOrZero()).To(be.True())
+
+	optFalse := maybe.False()
+	be.Expect(t, optFalse.IsNoneOrZero()).To(be.True())
+
+	optSome := maybe.Some(42)
+	be.Expect(t, optSome.IsNoneOrZero()).To(be.False())
+}
+
+func TestJSONOmitZero(t *testing.T) {
+	type payload struct {
+		Enabled maybe.Bool `json:"enabled,omitzero"`
+		Retries maybe.Int  `json:"retries,omitzero"`
+	}
+
+	cases := []struct {
+		name string
+		in   payload
+		want string
+	}{
+		{name: "True", in: payload{Enabled: maybe.True()}, want: `{"enabled":true}`},
+		{name: "False", in: payload{Enabled: maybe.False()}, want: `{"enabled":false}`},
+		{name: "NoneBool", in: payload{Enabled: maybe.NoneBool()}, want: `{}`},
+		{name: "Some(0)", in: payload{Retries: maybe.Some(0)}, want: `{"retries":0}`},
+		{name: "NoneInt", in: payload{Retries: maybe.NoneInt()}, want: `{}`},
+		{name: "zero value struct", in: payload{}, want: `{}`},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			data, err := json.Marshal(tc.in)
+			be.Expect(t, err).To(be.Succeed())
+			be.Expect(t, string(data)).To(be.Eq(tc.want))
+
+			// pointer to the payload goes through the same path
+			data, err = json.Marshal(&tc.in)
+			be.Expect(t, err).To(be.Succeed())
+			be.Expect(t, string(data)).To(be.Eq(tc.want))
+		})
+	}
+}
+
+func TestJSONOmitEmpty(t *testing.T) {
+	// encoding/json never treats structs as empty: omitempty keeps Option fields,
+	// None is still written as null.
+	type payload struct {
+		Enabled maybe.Bool `json:"enabled,omitempty"`
+		Retries maybe.Int  `json:"retries,omitempty"`
+	}
+
+	data, err := json.Marshal(payload{Enabled: maybe.False()})
+	be.Expect(t, err).To(be.Succeed())
+	be.Expect(t, string(data)).To(be.Eq(`{"enabled":false,"retries":null}`))
+}
+
 func TestBoolHelpers(t *testing.T) {
 	tru := maybe.True()
 	be.Expect(t, tru.Some(true)).To(be.True())
@@ -202,3 +472,120 @@ func TestReflectedAndSanity(t *testing.T) {
 	data, _ := maybe.None[int]().MarshalJSON()
 	be.Expect(t, string(data)).To(be.HaveLength(4)) // "null"
 }
+
+// textRoundTrip marshals opt to text and unmarshals it back into a fresh Option.
+func textRoundTrip[T comparable](t *testing.T, opt maybe.Option[T]) maybe.Option[T] {
+	t.Helper()
+
+	text, err := opt.MarshalText()
+	be.Require(t, err).To(be.Succeed())
+
+	var got maybe.Option[T]
+	be.Require(t, got.UnmarshalText(text)).To(be.Succeed())
+	return got
+}
+
+func TestMarshalText(t *testing.T) {
+	t.Run("None marshals to empty text", func(t *testing.T) {
+		text, err := maybe.NoneInt().MarshalText()
+		be.Expect(t, err).To(be.Succeed())
+		be.Expect(t, string(text)).To(be.Eq(""))
+	})
+
+	t.Run("scalars are rendered via strconv", func(t *testing.T) {
+		cases := map[string]encoding.TextMarshaler{
+			"42":     maybe.Some(42),
+			"-7":     maybe.Some(int8(-7)),
+			"7":      maybe.Some(uint64(7)),
+			"0.1":    maybe.Some(float32(0.1)),
+			"1e+21":  maybe.Some(1e21),
+			"false":  maybe.False(),
+			"hello":  maybe.Some("hello"),
+			"8080":   maybe.Some(port(8080)),
+			"1m30s":  maybe.Some(90 * time.Second),
+			"stable": maybe.Some(label("stable")),
+		}
+		for want, opt := range cases {
+			text, err := opt.MarshalText()
+			be.Expect(t, err).To(be.Succeed())
+			be.Expect(t, string(text)).To(be.Eq(want))
+		}
+	})
+
+	t.Run("TextMarshaler is preferred", func(t *testing.T) {
+		ts := time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)
+		text, err := maybe.Some(ts).MarshalText()
+		be.Expect(t, err).To(be.Succeed())
+		be.Expect(t, string(text)).To(be.Eq("2020-01-02T03:04:05Z"))
+	})
+
+	t.Run("other types fall back to fmt", func(t *testing.T) {
+		text, err := maybe.Some(struct{ A int }{A: 5}).MarshalText()
+		be.Expect(t, err).To(be.Succeed())
+		be.Expect(t, string(text)).To(be.Eq("{5}"))
+	})
+}
+
+func TestTextRoundTrip(t *testing.T) {
+	be.Expect(t, textRoundTrip(t, maybe.Some("hello world"))).To(be.Eq(maybe.Some("hello world")))
+	be.Expect(t, textRoundTrip(t, maybe.True())).To(be.Eq(maybe.True()))
+	be.Expect(t, textRoundTrip(t, maybe.False())).To(be.Eq(maybe.False()))
+	be.Expect(t, textRoundTrip(t, maybe.Some(-42))).To(be.Eq(maybe.Some(-42)))
+	be.Expect(t, textRoundTrip(t, maybe.Some(int8(-128)))).To(be.Eq(maybe.Some(int8(-128))))
+	be.Expect(t, textRoundTrip(t, maybe.Some(int16(300)))).To(be.Eq(maybe.Some(int16(300))))
+	be.Expect(t, textRoundTrip(t, maybe.Some(int32(-70000)))).To(be.Eq(maybe.Some(int32(-70000))))
+	be.Expect(t, textRoundTrip(t, maybe.Some(int64(1)<<62))).To(be.Eq(maybe.Some(int64(1) << 62)))
+	be.Expect(t, textRoundTrip(t, maybe.Some(uint(42)))).To(be.Eq(maybe.Some(uint(42))))
+	be.Expect(t, textRoundTrip(t, maybe.Some(uint8(255)))).To(be.Eq(maybe.Some(uint8(255))))
+	be.Expect(t, textRoundTrip(t, maybe.Some(uint16(65535)))).To(be.Eq(maybe.Some(uint16(65535))))
+	be.Expect(t, textRoundTrip(t, maybe.Some(uint32(1)<<31))).To(be.Eq(maybe.Some(uint32(1) << 31)))
+	be.Expect(t, textRoundTrip(t, maybe.Some(uint64(1)<<63))).To(be.Eq(maybe.Some(uint64(1) << 63)))
+	be.Expect(t, textRoundTrip(t, maybe.Some(float32(0.1)))).To(be.Eq(maybe.Some(float32(0.1))))
+	be.Expect(t, textRoundTrip(t, maybe.Some(3.141592653589793))).To(be.Eq(maybe.Some(3.141592653589793)))
+	be.Expect(t, textRoundTrip(t, maybe.Some(1500*time.Millisecond))).To(be.Eq(maybe.Some(1500 * time.Millisecond)))
+	be.Expect(t, textRoundTrip(t, maybe.Some(port(443)))).To(be.Eq(maybe.Some(port(443))))
+	be.Expect(t, textRoundTrip(t, maybe.Some(label("prod")))).To(be.Eq(maybe.Some(label("prod"))))
+	be.Expect(t, textRoundTrip(t, maybe.Some(ratio(0.75)))).To(be.Eq(maybe.Some(ratio(0.75))))
+	be.Expect(t, textRoundTrip(t, maybe.Some(severity(-3)))).To(be.Eq(maybe.Some(severity(-3))))
+	be.Expect(t, textRoundTrip(t, maybe.Some(enabled(true)))).To(be.Eq(maybe.Some(enabled(true))))
+	be.Expect(t, textRoundTrip(t, maybe.None[float64]())).To(be.Eq(maybe.None[float64]()))
+
+	ts := time.Date(2020, 1, 2, 3, 4, 5, 6, time.UTC)
+	got := textRoundTrip(t, maybe.Some(ts))
+	be.Expect(t, got.Unwrap().Equal(ts)).To(be.True())
+
+	t.Run("ambiguous strings are quoted", func(t *testing.T) {
+		cases := map[string]string{
+			"":             `""`,
+			" padded ":     `" padded "`,
+			"\ttab":        `"\ttab"`,
+			`"quoted"`:     `"\"quoted\""`,
+			"null":         `"null"`,
+			"None":         `"None"`,
+			"<a&b>":        "<a&b>",
+			`half"quoted`:  `half"quoted`,
+			`"`:            `"`,
+			"plain string": "plain string",
+		}
+		for in, wantText := range cases {
+			text, err := maybe.Some(in).MarshalText()
+			be.Require(t, err).To(be.Succeed())
+			be.Expect(t, string(text)).To(be.Eq(wantText))
+
+			be.Expect(t, textRoundTrip(t, maybe.Some(in))).To(be.Eq(maybe.Some(in)))
+			be.Expect(t, textRoundTrip(t, maybe.Some(label(in)))).To(be.Eq(maybe.Some(label(in))))
+		}
+	})
+}
+
+func TestOptionAsJSONMapKey(t *testing.T) {
+	m := map[maybe.Int]string{maybe.Some(1): "one", maybe.NoneInt(): "none"}
+
+	data, err := json.Marshal(m)
+	be.Expect(t, err).To(be.Succeed())
+	be.Expect(t, string(data)).To(be.Eq(`{"":"none","1":"one"}`))
+
+	var back map[maybe.Int]string
+	be.Expect(t, json.Unmarshal(data, &back)).To(be.Succeed())
+	be.Expect(t, back).To(be.Eq(m))
+}
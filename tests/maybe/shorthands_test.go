@@ -0,0 +1,213 @@
+package maybe_test
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/BurntSushi/toml"
+	"github.com/amberpixels/k1/maybe"
+	"github.com/expectto/be"
+)
+
+func TestNoneShorthands(t *testing.T) {
+	s := maybe.NoneString()
+	be.Expect(t, s.None()).To(be.True())
+
+	i64 := maybe.NoneInt64()
+	be.Expect(t, i64.None()).To(be.True())
+
+	u := maybe.NoneUint()
+	be.Expect(t, u.None()).To(be.True())
+
+	f := maybe.NoneFloat()
+	be.Expect(t, f.None()).To(be.True())
+
+	d := maybe.NoneDuration()
+	be.Expect(t, d.None()).To(be.True())
+
+	ts := maybe.NoneTime()
+	be.Expect(t, ts.None()).To(be.True())
+}
+
+// shorthandConfig holds one field per shorthand type.
+type shorthandConfig struct {
+	Name    maybe.String   `json:"name"    toml:"name"`
+	Size    maybe.Int64    `json:"size"    toml:"size"`
+	Workers maybe.Uint     `json:"workers" toml:"workers"`
+	Ratio   maybe.Float    `json:"ratio"   toml:"ratio"`
+	Timeout maybe.Duration `json:"timeout" toml:"timeout"`
+	Since   maybe.Time     `json:"since"   toml:"since"`
+}
+
+func sampleShorthandConfigs() map[string]shorthandConfig {
+	return map[string]shorthandConfig{
+		"values": {
+			Name:    maybe.Some("api"),
+			Size:    maybe.Some(int64(-1) << 40),
+			Workers: maybe.Some(uint(8)),
+			Ratio:   maybe.Some(0.25),
+			Timeout: maybe.Some(90 * time.Second),
+			Since:   maybe.Some(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC)),
+		},
+		"zero values": {
+			Name:    maybe.Some(""),
+			Size:    maybe.Some(int64(0)),
+			Workers: maybe.Some(uint(0)),
+			Ratio:   maybe.Some(0.0),
+			Timeout: maybe.Some(time.Duration(0)),
+			Since:   maybe.Some(time.Time{}.UTC()),
+		},
+		"nones": {
+			Name:    maybe.NoneString(),
+			Size:    maybe.NoneInt64(),
+			Workers: maybe.NoneUint(),
+			Ratio:   maybe.NoneFloat(),
+			Timeout: maybe.NoneDuration(),
+			Since:   maybe.NoneTime(),
+		},
+	}
+}
+
+// expectSameConfig compares configs, using time.Time.Equal for Since.
+func expectSameConfig(t *testing.T, got, want shorthandConfig) {
+	t.Helper()
+
+	be.Expect(t, got.Name).To(be.Eq(want.Name))
+	be.Expect(t, got.Size).To(be.Eq(want.Size))
+	be.Expect(t, got.Workers).To(be.Eq(want.Workers))
+	be.Expect(t, got.Ratio).To(be.Eq(want.Ratio))
+	be.Expect(t, got.Timeout).To(be.Eq(want.Timeout))
+
+	be.Expect(t, got.Since.Some()).To(be.Eq(want.Since.Some()))
+	if want.Since.Some() {
+		be.Expect(t, got.Since.Unwrap().Equal(want.Since.Unwrap())).To(be.True())
+	}
+}
+
+func TestShorthandsJSONRoundTrip(t *testing.T) {
+	for name, cfg := range sampleShorthandConfigs() {
+		t.Run(name, func(t *testing.T) {
+			data, err := json.Marshal(cfg)
+			be.Require(t, err).To(be.Succeed())
+
+			var back shorthandConfig
+			be.Require(t, json.Unmarshal(data, &back)).To(be.Succeed())
+			expectSameConfig(t, back, cfg)
+		})
+	}
+}
+
+func TestShorthandsTOMLRoundTrip(t *testing.T) {
+	for name, cfg := range sampleShorthandConfigs() {
+		t.Run(name, func(t *testing.T) {
+			var buf bytes.Buffer
+			be.Require(t, toml.NewEncoder(&buf).Encode(cfg)).To(be.Succeed())
+
+			var back shorthandConfig
+			_, err := toml.Decode(buf.String(), &back)
+			be.Require(t, err).To(be.Succeed())
+			expectSameConfig(t, back, cfg)
+		})
+	}
+}
+
+func TestShorthandsTextRoundTrip(t *testing.T) {
+	for name, cfg := range sampleShorthandConfigs() {
+		if name == "zero values" {
+			continue // Some("") is read back as None, see MarshalText
+		}
+		t.Run(name, func(t *testing.T) {
+			back := shorthandConfig{
+				Name:    textRoundTrip(t, cfg.Name),
+				Size:    textRoundTrip(t, cfg.Size),
+				Workers: textRoundTrip(t, cfg.Workers),
+				Ratio:   textRoundTrip(t, cfg.Ratio),
+				Timeout: textRoundTrip(t, cfg.Timeout),
+				Since:   textRoundTrip(t, cfg.Since),
+			}
+			expectSameConfig(t, back, cfg)
+		})
+	}
+}
+
+func TestTimeRFC3339Text(t *testing.T) {
+	var ts maybe.Time
+	be.Expect(t, ts.UnmarshalText([]byte("2024-05-06T07:08:09+02:00"))).To(be.Succeed())
+
+	want := time.Date(2024, 5, 6, 5, 8, 9, 0, time.UTC)
+	be.Expect(t, ts.Unwrap().Equal(want)).To(be.True())
+
+	text, err := ts.MarshalText()
+	be.Expect(t, err).To(be.Succeed())
+	be.Expect(t, string(text)).To(be.Eq("2024-05-06T07:08:09+02:00"))
+
+	be.Expect(t, ts.UnmarshalText([]byte("2024-05-06"))).To(be.HaveOccurred())
+}
+
+func TestUnmarshalTOML(t *testing.T) {
+	t.Run("native TOML values", func(t *testing.T) {
+		var cfg struct {
+			Port    maybe.Option[uint16]
+			Ratio   maybe.Float
+			Retries maybe.Int
+			Debug   maybe.Bool
+			Timeout maybe.Duration
+			Since   maybe.Time
+			Name    maybe.String
+		}
+		_, err := toml.Decode(`
+Port = 8080
+Ratio = 1
+Retries = 3.0
+Debug = false
+Timeout = "1m30s"
+Since = 2020-01-02T03:04:05Z
+Name = "  spaced  "
+`, &cfg)
+		be.Require(t, err).To(be.Succeed())
+
+		be.Expect(t, cfg.Port).To(be.Eq(maybe.Some(uint16(8080))))
+		be.Expect(t, cfg.Ratio).To(be.Eq(maybe.Some(1.0)))
+		be.Expect(t, cfg.Retries).To(be.Eq(maybe.Some(3)))
+		be.Expect(t, cfg.Debug).To(be.Eq(maybe.False()))
+		be.Expect(t, cfg.Timeout).To(be.Eq(maybe.Some(90 * time.Second)))
+		be.Expect(t, cfg.Since.Unwrap().Equal(time.Date(2020, 1, 2, 3, 4, 5, 0, time.UTC))).To(be.True())
+		be.Expect(t, cfg.Name).To(be.Eq(maybe.Some("  spaced  ")))
+	})
+
+	t.Run("TomlNone", func(t *testing.T) {
+		cfg := struct{ Port maybe.Int }{Port: maybe.Some(1)}
+		_, err := toml.Decode(`Port = "None"`, &cfg)
+		be.Expect(t, err).To(be.Succeed())
+		be.Expect(t, cfg.Port.None()).To(be.True())
+	})
+
+	t.Run("incompatible values", func(t *testing.T) {
+		var cfg struct{ Port maybe.Option[uint8] }
+		_, err := toml.Decode(`Port = 300`, &cfg)
+		be.Expect(t, err).To(be.HaveOccurred())
+
+		_, err = toml.Decode(`Port = -1`, &cfg)
+		be.Expect(t, err).To(be.HaveOccurred())
+
+		_, err = toml.Decode(`Port = 1.5`, &cfg)
+		be.Expect(t, err).To(be.HaveOccurred())
+
+		_, err = toml.Decode(`Port = true`, &cfg)
+		be.Expect(t, err).To(be.HaveOccurred())
+	})
+
+	t.Run("integral floats are range-checked in float64", func(t *testing.T) {
+		var u maybe.Option[uint64]
+		be.Expect(t, u.UnmarshalTOML(1e19)).To(be.Succeed())
+		be.Expect(t, u).To(be.Eq(maybe.Some(uint64(10_000_000_000_000_000_000))))
+		be.Expect(t, u.UnmarshalTOML(-1.0)).To(be.HaveOccurred())
+
+		var i maybe.Option[int64]
+		be.Expect(t, i.UnmarshalTOML(1e10)).To(be.Succeed())
+		be.Expect(t, i).To(be.Eq(maybe.Some(int64(10_000_000_000))))
+		be.Expect(t, i.UnmarshalTOML(9223372036854775808.0)).To(be.HaveOccurred())
+	})
+}
@@ -0,0 +1,64 @@
+package maybe_test
+
+import (
+	"testing"
+
+	"github.com/amberpixels/k1/maybe"
+	"github.com/expectto/be"
+)
+
+// int64Value mirrors the shape of wrapperspb.Int64Value.
+//
+//nolint:unused // unexported fields mirror the protobuf-generated internals
+type int64Value struct {
+	state         struct{ initialized bool }
+	sizeCache     int32
+	unknownFields []byte
+
+	Value int64
+}
+
+func (x *int64Value) GetValue() int64 {
+	if x != nil {
+		return x.Value
+	}
+	return 0
+}
+
+type stringValue struct {
+	Value string
+}
+
+func TestFromWrapper(t *testing.T) {
+	be.Expect(t, maybe.FromWrapper[int64](&int64Value{Value: 42})).To(be.Eq(maybe.Some(int64(42))))
+	be.Expect(t, maybe.FromWrapper[int64](&int64Value{})).To(be.Eq(maybe.Some(int64(0))))
+	be.Expect(t, maybe.FromWrapper[int64]((*int64Value)(nil))).To(be.Eq(maybe.NoneInt64()))
+
+	be.Expect(t, maybe.FromWrapper[string](&stringValue{Value: "x"})).To(be.Eq(maybe.Some("x")))
+}
+
+func TestToWrapper(t *testing.T) {
+	w := maybe.ToWrapper[int64Value](maybe.Some(int64(42)))
+	be.Expect(t, w.GetValue()).To(be.Eq(int64(42)))
+
+	be.Expect(t, maybe.ToWrapper[int64Value](maybe.NoneInt64())).To(be.Nil())
+
+	// round trip
+	opt := maybe.Some("hello")
+	be.Expect(t, maybe.FromWrapper[string](maybe.ToWrapper[stringValue](opt))).To(be.Eq(opt))
+}
+
+func TestWrapperShapeMismatch(t *testing.T) {
+	// Value is int64, not int
+	be.Expect(t, func() { maybe.FromWrapper[int](&int64Value{}) }).To(be.Panic())
+	be.Expect(t, func() { maybe.ToWrapper[int64Value](maybe.Some(1)) }).To(be.Panic())
+
+	// no Value field at all
+	be.Expect(t, func() { maybe.FromWrapper[int](&point{}) }).To(be.Panic())
+
+	// not a struct
+	be.Expect(t, func() { maybe.ToWrapper[int](maybe.Some(1)) }).To(be.Panic())
+
+	// shape is checked even for nil and None
+	be.Expect(t, func() { maybe.FromWrapper[int]((*int64Value)(nil)) }).To(be.Panic())
+}
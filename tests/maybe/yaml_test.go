@@ -0,0 +1,115 @@
+package maybe_test
+
+import (
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/amberpixels/k1/maybe"
+	"github.com/expectto/be"
+	"go.yaml.in/yaml/v3"
+)
+
+type yamlConfig struct {
+	Port    maybe.Int                   `yaml:"port"`
+	Name    maybe.Option[string]        `yaml:"name"`
+	Debug   maybe.Bool                  `yaml:"debug"`
+	Timeout maybe.Option[time.Duration] `yaml:"timeout"`
+	Ratio   maybe.Option[float64]       `yaml:"ratio"`
+}
+
+func TestYAMLUnmarshalling(t *testing.T) {
+	t.Run("values", func(t *testing.T) {
+		var cfg yamlConfig
+		err := yaml.Unmarshal([]byte("port: 8080\nname: api\ndebug: false\ntimeout: 1m30s\nratio: 0.5\n"), &cfg)
+		be.Expect(t, err).To(be.Succeed())
+
+		be.Expect(t, cfg.Port).To(be.Eq(maybe.Some(8080)))
+		be.Expect(t, cfg.Name).To(be.Eq(maybe.Some("api")))
+		be.Expect(t, cfg.Debug).To(be.Eq(maybe.False()))
+		be.Expect(t, cfg.Timeout).To(be.Eq(maybe.Some(90 * time.Second)))
+		be.Expect(t, cfg.Ratio).To(be.Eq(maybe.Some(0.5)))
+	})
+
+	t.Run("null, tilde and empty values are None", func(t *testing.T) {
+		var cfg yamlConfig
+		err := yaml.Unmarshal([]byte("port: null\nname: ~\ndebug:\n"), &cfg)
+		be.Expect(t, err).To(be.Succeed())
+
+		be.Expect(t, cfg.Port.None()).To(be.True())
+		be.Expect(t, cfg.Name.None()).To(be.True())
+		be.Expect(t, cfg.Debug.None()).To(be.True())
+	})
+
+	t.Run("missing keys are None", func(t *testing.T) {
+		var cfg yamlConfig
+		err := yaml.Unmarshal([]byte("port: 1\n"), &cfg)
+		be.Expect(t, err).To(be.Succeed())
+
+		be.Expect(t, cfg.Port).To(be.Eq(maybe.Some(1)))
+		be.Expect(t, cfg.Name.None()).To(be.True())
+		be.Expect(t, cfg.Debug.None()).To(be.True())
+		be.Expect(t, cfg.Timeout.None()).To(be.True())
+	})
+
+	t.Run("type mismatch is an error", func(t *testing.T) {
+		var cfg yamlConfig
+		err := yaml.Unmarshal([]byte("port: eighty\n"), &cfg)
+		be.Expect(t, err).To(be.HaveOccurred())
+	})
+}
+
+func TestYAMLMarshalling(t *testing.T) {
+	cfg := yamlConfig{Port: maybe.Some(8080), Debug: maybe.False()}
+
+	data, err := yaml.Marshal(cfg)
+	be.Expect(t, err).To(be.Succeed())
+	be.Expect(t, string(data)).To(be.Eq("port: 8080\nname: null\ndebug: false\ntimeout: null\nratio: null\n"))
+
+	var back yamlConfig
+	be.Expect(t, yaml.Unmarshal(data, &back)).To(be.Succeed())
+	be.Expect(t, back).To(be.Eq(cfg))
+}
+
+func TestUnmarshalYAMLV2Interface(t *testing.T) {
+	// YAML v2 hands over a decode callback; emulate it directly.
+	var opt maybe.Int
+	err := opt.UnmarshalYAML(func(any) error {
+		return nil // null: the pointer is left nil
+	})
+	be.Expect(t, err).To(be.Succeed())
+	be.Expect(t, opt.None()).To(be.True())
+
+	err = opt.UnmarshalYAML(func(v any) error {
+		n := 7
+		*(v.(**int)) = &n
+		return nil
+	})
+	be.Expect(t, err).To(be.Succeed())
+	be.Expect(t, opt).To(be.Eq(maybe.Some(7)))
+
+	errDecode := errors.New("decode failed")
+	err = opt.UnmarshalYAML(func(any) error { return errDecode })
+	be.Expect(t, err).To(be.MatchError(errDecode))
+}
+
+// yamlV3Wrapper is a YAML v3 unmarshaler that delegates to the node shim.
+type yamlV3Wrapper struct {
+	Port maybe.Int
+}
+
+func (w *yamlV3Wrapper) UnmarshalYAML(node *yaml.Node) error {
+	return w.Port.UnmarshalYAMLNode(node)
+}
+
+func TestUnmarshalYAMLNode(t *testing.T) {
+	var w yamlV3Wrapper
+	be.Expect(t, yaml.Unmarshal([]byte("42"), &w)).To(be.Succeed())
+	be.Expect(t, w.Port).To(be.Eq(maybe.Some(42)))
+
+	// Called directly with a null node, the shim resets the Option to None.
+	var node yaml.Node
+	be.Require(t, yaml.Unmarshal([]byte("~"), &node)).To(be.Succeed())
+	be.Expect(t, w.Port.UnmarshalYAMLNode(&node)).To(be.Succeed())
+	be.Expect(t, w.Port.None()).To(be.True())
+}
@@ -0,0 +1,41 @@
+package quick_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/amberpixels/k1/quick"
+	"github.com/expectto/be"
+)
+
+type apiRecord struct {
+	ID     string
+	Fields map[string]string
+}
+
+// TestAppendBy verifies elements are deduplicated by key, keeping the first full element.
+func TestAppendBy(t *testing.T) {
+	id := func(r apiRecord) string { return r.ID }
+
+	page1 := []apiRecord{{ID: "a", Fields: map[string]string{"v": "1"}}, {ID: "b"}}
+	page2 := []apiRecord{{ID: "b", Fields: map[string]string{"v": "2"}}, {ID: "c"}, {ID: "c"}}
+
+	got := quick.AppendBy(page1, id, page2...)
+	be.Expect(t, got).To(be.HaveLength(3))
+	be.Expect(t, got[0].ID).To(be.Eq("a"))
+	be.Expect(t, got[1].ID).To(be.Eq("b"))
+	be.Expect(t, got[1].Fields).To(be.Nil())
+	be.Expect(t, got[2].ID).To(be.Eq("c"))
+
+	// inputs are untouched
+	be.Expect(t, page1).To(be.HaveLength(2))
+}
+
+// TestAppendByEdgeCases verifies empty inputs and respected old duplicates, as in Append.
+func TestAppendByEdgeCases(t *testing.T) {
+	lower := strings.ToLower
+
+	be.Expect(t, quick.AppendBy([]string{"A"}, lower)).To(be.Eq([]string{"A"}))
+	be.Expect(t, quick.AppendBy(nil, lower, "a", "A", "b")).To(be.Eq([]string{"a", "b"}))
+	be.Expect(t, quick.AppendBy([]string{"a", "A"}, lower, "b", "a")).To(be.Eq([]string{"a", "A", "b"}))
+}
@@ -0,0 +1,125 @@
+package result_test
+
+import (
+	"errors"
+	"io/fs"
+	"strconv"
+	"testing"
+
+	"github.com/amberpixels/k1/cast"
+	"github.com/amberpixels/k1/maybe"
+	"github.com/amberpixels/k1/result"
+	"github.com/expectto/be"
+)
+
+var errBoom = errors.New("boom")
+
+func TestOkAndErr(t *testing.T) {
+	ok := result.Ok(42)
+	be.Expect(t, ok.IsOk()).To(be.True())
+	be.Expect(t, ok.IsErr()).To(be.False())
+	be.Expect(t, ok.Unwrap()).To(be.Eq(42))
+	be.Expect(t, ok.Err()).To(be.Nil())
+	be.Expect(t, func() { _ = ok.UnwrapErr() }).To(be.Panic())
+
+	failed := result.Err[int](errBoom)
+	be.Expect(t, failed.IsOk()).To(be.False())
+	be.Expect(t, failed.IsErr()).To(be.True())
+	be.Expect(t, failed.UnwrapErr()).To(be.MatchError(errBoom))
+	be.Expect(t, failed.Err()).To(be.MatchError(errBoom))
+	be.Expect(t, func() { failed.Unwrap() }).To(be.Panic())
+
+	be.Expect(t, func() { result.Err[int](nil) }).To(be.Panic())
+
+	// The zero value is Ok(zero).
+	var zero result.Result[string]
+	be.Expect(t, zero.IsOk()).To(be.True())
+	be.Expect(t, zero.Unwrap()).To(be.Eq(""))
+}
+
+func TestOfAndGet(t *testing.T) {
+	n := result.Of(strconv.Atoi("42"))
+	v, err := n.Get()
+	be.Expect(t, err).To(be.Succeed())
+	be.Expect(t, v).To(be.Eq(42))
+
+	bad := result.Of(strconv.Atoi("forty-two"))
+	v, err = bad.Get()
+	be.Expect(t, err).To(be.HaveOccurred())
+	be.Expect(t, v).To(be.Eq(0))
+}
+
+func TestTry(t *testing.T) {
+	ok := result.Try(func() int { return cast.AsInt(42.0) })
+	be.Expect(t, ok.Unwrap()).To(be.Eq(42))
+
+	// cast panics on impossible conversions: the panic becomes an error.
+	failed := result.Try(func() int { return cast.AsInt(42.5) })
+	be.Expect(t, failed.IsErr()).To(be.True())
+
+	// panics with an error value are kept as is
+	failedWithErr := result.Try(func() int { panic(errBoom) })
+	be.Expect(t, failedWithErr.Is(errBoom)).To(be.True())
+}
+
+func TestMap(t *testing.T) {
+	doubled := result.Map(result.Ok(21), func(n int) int { return n * 2 })
+	be.Expect(t, doubled.Unwrap()).To(be.Eq(42))
+
+	called := false
+	failed := result.Map(result.Err[int](errBoom), func(n int) string {
+		called = true
+		return strconv.Itoa(n)
+	})
+	be.Expect(t, called).To(be.False())
+	be.Expect(t, failed.Is(errBoom)).To(be.True())
+}
+
+func TestAndThen(t *testing.T) {
+	validatePort := func(n int) result.Result[uint16] {
+		if n <= 0 || n > 65535 {
+			return result.Err[uint16](errors.New("invalid port"))
+		}
+		return result.Ok(uint16(n))
+	}
+
+	port := result.AndThen(result.Of(strconv.Atoi("8080")), validatePort)
+	be.Expect(t, port.Unwrap()).To(be.Eq(uint16(8080)))
+
+	port = result.AndThen(result.Of(strconv.Atoi("70000")), validatePort)
+	be.Expect(t, port.UnwrapErr()).To(be.MatchError(errors.New("invalid port")))
+
+	// the first error short-circuits the chain
+	port = result.AndThen(result.Of(strconv.Atoi("http")), validatePort)
+	be.Expect(t, port.As(new(*strconv.NumError))).To(be.True())
+}
+
+func TestIsAndAs(t *testing.T) {
+	failed := result.Err[string](&fs.PathError{Op: "open", Path: "/nope", Err: fs.ErrNotExist})
+
+	be.Expect(t, failed.Is(fs.ErrNotExist)).To(be.True())
+	be.Expect(t, failed.Is(errBoom)).To(be.False())
+
+	var pathErr *fs.PathError
+	be.Expect(t, failed.As(&pathErr)).To(be.True())
+	be.Expect(t, pathErr.Path).To(be.Eq("/nope"))
+
+	ok := result.Ok("fine")
+	be.Expect(t, ok.Is(fs.ErrNotExist)).To(be.False())
+	be.Expect(t, ok.As(&pathErr)).To(be.False())
+}
+
+func TestOptionConversions(t *testing.T) {
+	be.Expect(t, result.ToOption(result.Ok(1))).To(be.Eq(maybe.Some(1)))
+	be.Expect(t, result.ToOption(result.Err[int](errBoom))).To(be.Eq(maybe.NoneInt()))
+
+	fromSome := result.FromOption(maybe.Some("x"), errBoom)
+	be.Expect(t, fromSome.Unwrap()).To(be.Eq("x"))
+
+	fromNone := result.FromOption(maybe.NoneString(), errBoom)
+	be.Expect(t, fromNone.Is(errBoom)).To(be.True())
+
+	// a nil error falls back to ErrNone instead of panicking
+	fromNoneNil := result.FromOption(maybe.NoneString(), nil)
+	be.Expect(t, fromNoneNil.Is(result.ErrNone)).To(be.True())
+}
@@ -0,0 +1,122 @@
+package set_test
+
+import (
+	"testing"
+
+	"github.com/amberpixels/k1/set"
+	"github.com/expectto/be"
+)
+
+// TestUnion verifies Union returns all the elements of both lookups and leaves the inputs untouched.
+func TestUnion(t *testing.T) {
+	a := set.NewLookup(1, 2, 3)
+	b := set.NewLookup(3, 4)
+
+	be.Expect(t, a.Union(b)).To(be.Eq(set.NewLookup(1, 2, 3, 4)))
+	be.Expect(t, b.Union(a)).To(be.Eq(set.NewLookup(1, 2, 3, 4)))
+	be.Expect(t, a).To(be.Eq(set.NewLookup(1, 2, 3)))
+	be.Expect(t, b).To(be.Eq(set.NewLookup(3, 4)))
+
+	// the result never aliases an input
+	u := a.Union(set.NewLookup[int]())
+	u.Add(100)
+	be.Expect(t, a).To(be.Not(be.HaveKey(100)))
+
+	// nil lookups are empty ones
+	var nilLookup set.Lookup[int]
+	be.Expect(t, nilLookup.Union(nil)).To(be.Eq(set.NewLookup[int]()))
+}
+
+// TestIntersection verifies Intersection keeps only common elements.
+func TestIntersection(t *testing.T) {
+	a := set.NewLookup(1, 2, 3, 4, 5)
+	b := set.NewLookup(4, 5, 6)
+
+	be.Expect(t, a.Intersection(b)).To(be.Eq(set.NewLookup(4, 5)))
+	be.Expect(t, b.Intersection(a)).To(be.Eq(set.NewLookup(4, 5)))
+	be.Expect(t, a.Intersection(set.NewLookup(7))).To(be.HaveLength(0))
+	be.Expect(t, a).To(be.HaveLength(5))
+}
+
+// TestDifference verifies Difference keeps the elements of the receiver that are not in other.
+func TestDifference(t *testing.T) {
+	a := set.NewLookup(1, 2, 3, 4)
+	b := set.NewLookup(3, 4, 5)
+
+	be.Expect(t, a.Difference(b)).To(be.Eq(set.NewLookup(1, 2)))
+	be.Expect(t, b.Difference(a)).To(be.Eq(set.NewLookup(5)))
+	be.Expect(t, a.Difference(nil)).To(be.Eq(a))
+}
+
+// TestSymmetricDifference verifies SymmetricDifference keeps elements that are in exactly one lookup.
+func TestSymmetricDifference(t *testing.T) {
+	a := set.NewLookup(1, 2, 3)
+	b := set.NewLookup(3, 4)
+
+	be.Expect(t, a.SymmetricDifference(b)).To(be.Eq(set.NewLookup(1, 2, 4)))
+	be.Expect(t, b.SymmetricDifference(a)).To(be.Eq(set.NewLookup(1, 2, 4)))
+	be.Expect(t, a.SymmetricDifference(a)).To(be.HaveLength(0))
+}
+
+// TestInPlaceAlgebra verifies the ...With methods modify the receiver only.
+func TestInPlaceAlgebra(t *testing.T) {
+	t.Run("UnionWith", func(t *testing.T) {
+		a, b := set.NewLookup(1, 2), set.NewLookup(2, 3)
+		a.UnionWith(b)
+		be.Expect(t, a).To(be.Eq(set.NewLookup(1, 2, 3)))
+		be.Expect(t, b).To(be.Eq(set.NewLookup(2, 3)))
+	})
+
+	t.Run("IntersectionWith", func(t *testing.T) {
+		a, b := set.NewLookup(1, 2, 3), set.NewLookup(2, 3, 4)
+		a.IntersectionWith(b)
+		be.Expect(t, a).To(be.Eq(set.NewLookup(2, 3)))
+		be.Expect(t, b).To(be.Eq(set.NewLookup(2, 3, 4)))
+	})
+
+	t.Run("DifferenceWith", func(t *testing.T) {
+		// other is smaller than the receiver
+		a := set.NewLookup(1, 2, 3, 4)
+		a.DifferenceWith(set.NewLookup(4, 5))
+		be.Expect(t, a).To(be.Eq(set.NewLookup(1, 2, 3)))
+
+		// other is bigger than the receiver
+		b := set.NewLookup(1, 2)
+		b.DifferenceWith(set.NewLookup(2, 3, 4, 5))
+		be.Expect(t, b).To(be.Eq(set.NewLookup(1)))
+	})
+
+	t.Run("SymmetricDifferenceWith", func(t *testing.T) {
+		a, b := set.NewLookup(1, 2, 3), set.NewLookup(3, 4)
+		a.SymmetricDifferenceWith(b)
+		be.Expect(t, a).To(be.Eq(set.NewLookup(1, 2, 4)))
+		be.Expect(t, b).To(be.Eq(set.NewLookup(3, 4)))
+	})
+}
+
+// TestPredicates verifies IsSubset, IsSuperset, IsDisjoint and Equal.
+func TestPredicates(t *testing.T) {
+	small := set.NewLookup("a", "b")
+	big := set.NewLookup("a", "b", "c")
+	other := set.NewLookup("x", "y")
+	empty := set.NewLookup[string]()
+
+	be.Expect(t, small.IsSubset(big)).To(be.True())
+	be.Expect(t, big.IsSubset(small)).To(be.False())
+	be.Expect(t, small.IsSubset(small)).To(be.True())
+	be.Expect(t, empty.IsSubset(small)).To(be.True())
+	be.Expect(t, set.NewLookup("a", "z").IsSubset(big)).To(be.False())
+
+	be.Expect(t, big.IsSuperset(small)).To(be.True())
+	be.Expect(t, small.IsSuperset(big)).To(be.False())
+	be.Expect(t, small.IsSuperset(empty)).To(be.True())
+
+	be.Expect(t, small.IsDisjoint(other)).To(be.True())
+	be.Expect(t, big.IsDisjoint(small)).To(be.False())
+	be.Expect(t, empty.IsDisjoint(empty)).To(be.True())
+
+	be.Expect(t, small.Equal(set.NewLookup("b", "a"))).To(be.True())
+	be.Expect(t, small.Equal(big)).To(be.False())
+	be.Expect(t, small.Equal(set.NewLookup("a", "c"))).To(be.False())
+	be.Expect(t, empty.Equal(nil)).To(be.True())
+}
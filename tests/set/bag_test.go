@@ -0,0 +1,101 @@
+package set_test
+
+import (
+	"maps"
+	"testing"
+
+	"github.com/amberpixels/k1/set"
+	"github.com/expectto/be"
+)
+
+// TestBagCounting verifies Add, Remove, Delete, Count, Total and Len.
+func TestBagCounting(t *testing.T) {
+	b := set.NewBag("a", "b", "a")
+	be.Expect(t, b.Count("a")).To(be.Eq(2))
+	be.Expect(t, b.Count("b")).To(be.Eq(1))
+	be.Expect(t, b.Count("z")).To(be.Eq(0))
+	be.Expect(t, b.Len()).To(be.Eq(2))
+	be.Expect(t, b.Total()).To(be.Eq(3))
+
+	b.Add("c", 5)
+	b.Add("c", 0)  // no-op
+	b.Add("c", -1) // no-op
+	be.Expect(t, b.Count("c")).To(be.Eq(5))
+	be.Expect(t, b.Total()).To(be.Eq(8))
+
+	b.Remove("c", 2)
+	be.Expect(t, b.Count("c")).To(be.Eq(3))
+
+	// removing more than there is drops the element entirely
+	b.Remove("c", 10)
+	be.Expect(t, b.Has("c")).To(be.False())
+	be.Expect(t, b).To(be.Not(be.HaveKey("c")))
+
+	b.Delete("a")
+	be.Expect(t, b).To(be.Eq(set.Bag[string]{"b": 1}))
+
+	b.Clear()
+	be.Expect(t, b.Len()).To(be.Eq(0))
+	be.Expect(t, b.Total()).To(be.Eq(0))
+}
+
+// TestBagAll verifies All yields every distinct element with its count.
+func TestBagAll(t *testing.T) {
+	b := set.NewBag(1, 1, 2)
+	be.Expect(t, maps.Collect(b.All())).To(be.Eq(map[int]int{1: 2, 2: 1}))
+}
+
+// TestBagMostCommon verifies ordering by count and deterministic tie-breaking.
+func TestBagMostCommon(t *testing.T) {
+	b := set.NewBag("x", "b", "a", "c", "a", "b", "x", "x")
+
+	be.Expect(t, b.MostCommon(1)).To(be.Eq([]set.BagEntry[string]{{Value: "x", Count: 3}}))
+	be.Expect(t, b.MostCommon(3)).To(be.Eq([]set.BagEntry[string]{
+		{Value: "x", Count: 3}, {Value: "a", Count: 2}, {Value: "b", Count: 2},
+	}))
+	be.Expect(t, b.MostCommon(-1)).To(be.HaveLength(4))
+	be.Expect(t, b.MostCommon(100)).To(be.HaveLength(4))
+	be.Expect(t, b.MostCommon(0)).To(be.HaveLength(0))
+
+	t.Run("numeric ties in numeric order", func(t *testing.T) {
+		codes := set.NewBag(500, 404, 10, 404, 500)
+		be.Expect(t, codes.MostCommon(-1)).To(be.Eq([]set.BagEntry[int]{
+			{Value: 404, Count: 2}, {Value: 500, Count: 2}, {Value: 10, Count: 1},
+		}))
+	})
+
+	t.Run("non-ordered elements are deterministic too", func(t *testing.T) {
+		b := set.NewBag(coord{2, 0}, coord{1, 0}, coord{3, 0})
+		for range 10 {
+			be.Expect(t, b.MostCommon(1)).To(be.Eq([]set.BagEntry[coord]{{Value: coord{1, 0}, Count: 1}}))
+		}
+	})
+}
+
+// TestBagUnionIntersection verifies multiset union (max counts) and intersection (min counts).
+func TestBagUnionIntersection(t *testing.T) {
+	a := set.Bag[string]{"x": 3, "y": 1}
+	b := set.Bag[string]{"x": 1, "y": 2, "z": 4}
+
+	be.Expect(t, a.Union(b)).To(be.Eq(set.Bag[string]{"x": 3, "y": 2, "z": 4}))
+	be.Expect(t, b.Union(a)).To(be.Eq(set.Bag[string]{"x": 3, "y": 2, "z": 4}))
+	be.Expect(t, a.Intersection(b)).To(be.Eq(set.Bag[string]{"x": 1, "y": 1}))
+	be.Expect(t, b.Intersection(a)).To(be.Eq(set.Bag[string]{"x": 1, "y": 1}))
+
+	// inputs are untouched
+	be.Expect(t, a).To(be.Eq(set.Bag[string]{"x": 3, "y": 1}))
+
+	var empty set.Bag[string]
+	be.Expect(t, empty.Union(a)).To(be.Eq(a))
+	be.Expect(t, empty.Intersection(a)).To(be.HaveLength(0))
+}
+
+// TestBagLookupConversion verifies conversion between Bag and Lookup.
+func TestBagLookupConversion(t *testing.T) {
+	b := set.NewBag("a", "a", "b")
+	be.Expect(t, b.Lookup()).To(be.Eq(set.NewLookup("a", "b")))
+
+	l := set.NewLookup("a", "b")
+	be.Expect(t, l.Bag()).To(be.Eq(set.Bag[string]{"a": 1, "b": 1}))
+	be.Expect(t, l.Bag().Lookup()).To(be.Eq(l))
+}
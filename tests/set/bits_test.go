@@ -0,0 +1,185 @@
+package set_test
+
+import (
+	"fmt"
+	"slices"
+	"testing"
+
+	"github.com/amberpixels/k1/set"
+	"github.com/expectto/be"
+)
+
+type permission uint8
+
+const (
+	permRead permission = iota
+	permWrite
+	permExec
+)
+
+// TestBitsBasics verifies Has, Add, Delete, Len and Clear, including the zero value.
+func TestBitsBasics(t *testing.T) {
+	var b set.Bits[int]
+	be.Expect(t, b.Len()).To(be.Eq(0))
+	be.Expect(t, b.Has(0)).To(be.False())
+
+	b.Add(0)
+	b.Add(63)
+	b.Add(64)
+	b.Add(1000)
+	b.Add(64) // idempotent
+	be.Expect(t, b.Len()).To(be.Eq(4))
+	be.Expect(t, b.Has(63)).To(be.True())
+	be.Expect(t, b.Has(64)).To(be.True())
+	be.Expect(t, b.Has(65)).To(be.False())
+	be.Expect(t, b.Has(100_000)).To(be.False())
+	be.Expect(t, b.Has(-1)).To(be.False())
+
+	b.Delete(63)
+	b.Delete(-5)     // no-op
+	b.Delete(99_999) // no-op
+	be.Expect(t, b.Has(63)).To(be.False())
+	be.Expect(t, b.Len()).To(be.Eq(3))
+
+	b.Clear()
+	be.Expect(t, b.Len()).To(be.Eq(0))
+	be.Expect(t, b.Has(0)).To(be.False())
+
+	be.Expect(t, func() { b.Add(-1) }).To(be.Panic())
+}
+
+// TestBitsEnum verifies bitsets work for custom enum-like types.
+func TestBitsEnum(t *testing.T) {
+	perms := set.NewBits(permExec, permRead)
+	be.Expect(t, perms.Has(permRead)).To(be.True())
+	be.Expect(t, perms.Has(permWrite)).To(be.False())
+	be.Expect(t, perms.Slice()).To(be.Eq([]permission{permRead, permExec}))
+}
+
+// TestBitsAscendingIteration verifies All and Slice yield elements in ascending order.
+func TestBitsAscendingIteration(t *testing.T) {
+	b := set.NewBits[uint](200, 3, 64, 0, 127)
+	be.Expect(t, slices.Collect(b.All())).To(be.Eq([]uint{0, 3, 64, 127, 200}))
+
+	var first []uint
+	for v := range b.All() {
+		first = append(first, v)
+		if len(first) == 2 {
+			break
+		}
+	}
+	be.Expect(t, first).To(be.Eq([]uint{0, 3}))
+
+	be.Expect(t, set.NewBits[int]().Slice()).To(be.Eq([]int{}))
+}
+
+// TestBitsAlgebra verifies word-wise set algebra against Lookup results.
+func TestBitsAlgebra(t *testing.T) {
+	a := func() *set.Bits[int] { return set.NewBits(1, 2, 70, 130) }
+	b := func() *set.Bits[int] { return set.NewBits(2, 3, 130) }
+
+	be.Expect(t, a().Union(b()).Slice()).To(be.Eq([]int{1, 2, 3, 70, 130}))
+	be.Expect(t, b().Union(a()).Slice()).To(be.Eq([]int{1, 2, 3, 70, 130}))
+	be.Expect(t, a().Intersection(b()).Slice()).To(be.Eq([]int{2, 130}))
+	be.Expect(t, b().Intersection(a()).Slice()).To(be.Eq([]int{2, 130}))
+	be.Expect(t, a().Difference(b()).Slice()).To(be.Eq([]int{1, 70}))
+	be.Expect(t, b().Difference(a()).Slice()).To(be.Eq([]int{3}))
+	be.Expect(t, a().SymmetricDifference(b()).Slice()).To(be.Eq([]int{1, 3, 70}))
+
+	// allocating forms leave inputs untouched
+	x := a()
+	_ = x.Union(set.NewBits(500))
+	_ = x.Intersection(b())
+	be.Expect(t, x.Slice()).To(be.Eq([]int{1, 2, 70, 130}))
+
+	t.Run("in place", func(t *testing.T) {
+		x := a()
+		x.UnionWith(b())
+		be.Expect(t, x.Slice()).To(be.Eq([]int{1, 2, 3, 70, 130}))
+
+		x = a()
+		x.IntersectionWith(b())
+		be.Expect(t, x.Slice()).To(be.Eq([]int{2, 130}))
+
+		x = a()
+		x.DifferenceWith(b())
+		be.Expect(t, x.Slice()).To(be.Eq([]int{1, 70}))
+
+		x = a()
+		x.SymmetricDifferenceWith(b())
+		be.Expect(t, x.Slice()).To(be.Eq([]int{1, 3, 70}))
+	})
+}
+
+// TestBitsPredicates verifies predicates, which must ignore trailing empty words.
+func TestBitsPredicates(t *testing.T) {
+	small := set.NewBits(1, 2)
+	big := set.NewBits(1, 2, 300)
+
+	be.Expect(t, small.IsSubset(big)).To(be.True())
+	be.Expect(t, big.IsSubset(small)).To(be.False())
+	be.Expect(t, big.IsSuperset(small)).To(be.True())
+	be.Expect(t, small.IsDisjoint(set.NewBits(3, 300))).To(be.True())
+	be.Expect(t, big.IsDisjoint(set.NewBits(3, 300))).To(be.False())
+
+	big.Delete(300)
+	be.Expect(t, big.Equal(small)).To(be.True())
+	be.Expect(t, small.Equal(big)).To(be.True())
+	be.Expect(t, big.IsSubset(small)).To(be.True())
+	be.Expect(t, small.Equal(set.NewBits(1, 3))).To(be.False())
+	be.Expect(t, set.NewBits[int]().Equal(&set.Bits[int]{})).To(be.True())
+}
+
+// BenchmarkBitsVsLookup compares Bits with Lookup on a dense small integer domain.
+func BenchmarkBitsVsLookup(b *testing.B) {
+	for _, size := range []int{64, 1024} {
+		evens := make([]int, 0, size/2)
+		odds := make([]int, 0, size/2)
+		for i := range size {
+			if i%2 == 0 {
+				evens = append(evens, i)
+			} else {
+				odds = append(odds, i)
+			}
+		}
+
+		b.Run(fmt.Sprintf("Has/Bits/%d", size), func(b *testing.B) {
+			s := set.NewBits(evens...)
+			for i := range b.N {
+				_ = s.Has(i % size)
+			}
+		})
+		b.Run(fmt.Sprintf("Has/Lookup/%d", size), func(b *testing.B) {
+			s := set.NewLookup(evens...)
+			for i := range b.N {
+				_ = s.Has(i % size)
+			}
+		})
+
+		b.Run(fmt.Sprintf("Union/Bits/%d", size), func(b *testing.B) {
+			x, y := set.NewBits(evens...), set.NewBits(odds...)
+			for range b.N {
+				_ = x.Union(y)
+			}
+		})
+		b.Run(fmt.Sprintf("Union/Lookup/%d", size), func(b *testing.B) {
+			x, y := set.NewLookup(evens...), set.NewLookup(odds...)
+			for range b.N {
+				_ = x.Union(y)
+			}
+		})
+
+		b.Run(fmt.Sprintf("Intersection/Bits/%d", size), func(b *testing.B) {
+			x, y := set.NewBits(evens...), set.NewBits(odds...)
+			for range b.N {
+				_ = x.Intersection(y)
+			}
+		})
+		b.Run(fmt.Sprintf("Intersection/Lookup/%d", size), func(b *testing.B) {
+			x, y := set.NewLookup(evens...), set.NewLookup(odds...)
+			for range b.N {
+				_ = x.Intersection(y)
+			}
+		})
+	}
+}
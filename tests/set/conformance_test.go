@@ -0,0 +1,143 @@
+package set_test
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/amberpixels/k1/set"
+	"github.com/expectto/be"
+)
+
+// implementations lists constructors of every set.Set implementation. A new implementation must be added here
+// so it passes the conformance suite. Elements are small non-negative ints, so Bits qualifies too.
+var implementations = []struct {
+	name string
+	new  func() set.Set[int]
+}{
+	{"Lookup", func() set.Set[int] { return set.NewLookup[int]() }},
+	{"Ordered", func() set.Set[int] { return set.NewOrdered[int]() }},
+	{"Sync", func() set.Set[int] { return set.NewSync[int]() }},
+	{"Bits", func() set.Set[int] { return set.NewBits[int]() }},
+	{"Sorted", func() set.Set[int] { return set.NewSorted[int]() }},
+	{"KeyedLookup", func() set.Set[int] { return set.NewKeyedLookup(func(v int) int { return v }) }},
+}
+
+// fill adds the given elements into s and returns it.
+func fill(s set.Set[int], elems ...int) set.Set[int] {
+	for _, v := range elems {
+		s.Add(v)
+	}
+	return s
+}
+
+// sortedElems returns the elements of s in ascending order.
+func sortedElems(s set.Set[int]) []int {
+	return slices.Sorted(s.All())
+}
+
+// TestConformance runs the shared set.Set suite against every implementation.
+func TestConformance(t *testing.T) {
+	for _, impl := range implementations {
+		t.Run(impl.name, func(t *testing.T) {
+			testConformance(t, impl.new)
+		})
+	}
+}
+
+func testConformance(t *testing.T, newSet func() set.Set[int]) {
+	t.Helper()
+
+	t.Run("empty", func(t *testing.T) {
+		s := newSet()
+		be.Expect(t, s.Len()).To(be.Eq(0))
+		be.Expect(t, s.Has(0)).To(be.False())
+		be.Expect(t, sortedElems(s)).To(be.HaveLength(0))
+	})
+
+	t.Run("add and has", func(t *testing.T) {
+		s := fill(newSet(), 3, 0, 70, 3)
+		be.Expect(t, s.Len()).To(be.Eq(3))
+		be.Expect(t, s.Has(0)).To(be.True())
+		be.Expect(t, s.Has(70)).To(be.True())
+		be.Expect(t, s.Has(4)).To(be.False())
+	})
+
+	t.Run("delete", func(t *testing.T) {
+		s := fill(newSet(), 1, 2, 3)
+		s.Delete(2)
+		s.Delete(99) // no-op
+		be.Expect(t, s.Has(2)).To(be.False())
+		be.Expect(t, s.Len()).To(be.Eq(2))
+		be.Expect(t, sortedElems(s)).To(be.Eq([]int{1, 3}))
+
+		s.Add(2)
+		be.Expect(t, s.Has(2)).To(be.True())
+	})
+
+	t.Run("clear", func(t *testing.T) {
+		s := fill(newSet(), 1, 2, 3)
+		s.Clear()
+		be.Expect(t, s.Len()).To(be.Eq(0))
+		be.Expect(t, s.Has(1)).To(be.False())
+
+		s.Add(5)
+		be.Expect(t, sortedElems(s)).To(be.Eq([]int{5}))
+	})
+
+	t.Run("all", func(t *testing.T) {
+		s := fill(newSet(), 5, 1, 3)
+		be.Expect(t, sortedElems(s)).To(be.Eq([]int{1, 3, 5}))
+
+		n := 0
+		for range s.All() {
+			n++
+			break
+		}
+		be.Expect(t, n).To(be.Eq(1))
+	})
+
+	t.Run("algebra", func(t *testing.T) {
+		a := fill(newSet(), 1, 2, 3, 4)
+		b := fill(newSet(), 3, 4, 5)
+
+		be.Expect(t, sortedElems(set.Union(newSet(), a, b))).To(be.Eq([]int{1, 2, 3, 4, 5}))
+		be.Expect(t, sortedElems(set.Intersection(newSet(), a, b))).To(be.Eq([]int{3, 4}))
+		be.Expect(t, sortedElems(set.Difference(newSet(), a, b))).To(be.Eq([]int{1, 2}))
+		be.Expect(t, sortedElems(set.SymmetricDifference(newSet(), a, b))).To(be.Eq([]int{1, 2, 5}))
+
+		// inputs are untouched
+		be.Expect(t, sortedElems(a)).To(be.Eq([]int{1, 2, 3, 4}))
+		be.Expect(t, sortedElems(b)).To(be.Eq([]int{3, 4, 5}))
+	})
+
+	t.Run("predicates", func(t *testing.T) {
+		small := fill(newSet(), 1, 2)
+		big := fill(newSet(), 1, 2, 3)
+		other := fill(newSet(), 7)
+
+		be.Expect(t, set.IsSubset(small, big)).To(be.True())
+		be.Expect(t, set.IsSubset(big, small)).To(be.False())
+		be.Expect(t, set.IsSuperset(big, small)).To(be.True())
+		be.Expect(t, set.IsDisjoint(small, other)).To(be.True())
+		be.Expect(t, set.IsDisjoint(small, big)).To(be.False())
+		be.Expect(t, set.Equal(small, fill(newSet(), 2, 1))).To(be.True())
+		be.Expect(t, set.Equal(small, big)).To(be.False())
+	})
+}
+
+// TestMixedImplementations verifies the generic algebra works across different implementations.
+func TestMixedImplementations(t *testing.T) {
+	lookup := set.NewLookup(1, 2, 3)
+	bits := set.NewBits(3, 4)
+	sorted := set.NewSorted(4, 5)
+
+	u := set.Union(set.NewSorted[int](), lookup, bits, sorted)
+	be.Expect(t, u.Slice()).To(be.Eq([]int{1, 2, 3, 4, 5}))
+
+	i := set.Intersection(set.NewLookup[int](), lookup, bits)
+	be.Expect(t, i).To(be.Eq(set.NewLookup(3)))
+
+	be.Expect(t, set.IsSubset(bits, u)).To(be.True())
+	be.Expect(t, set.Equal(lookup, set.NewOrdered(3, 2, 1))).To(be.True())
+	be.Expect(t, lookup.Len()).To(be.Eq(3))
+}
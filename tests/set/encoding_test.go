@@ -0,0 +1,224 @@
+package set_test
+
+import (
+	"encoding/json"
+	"flag"
+	"testing"
+
+	"github.com/amberpixels/k1/set"
+	"github.com/expectto/be"
+	"go.yaml.in/yaml/v3"
+)
+
+type color string
+
+type level int
+
+type coord struct {
+	X, Y int
+}
+
+// TestMarshalJSON verifies lookups marshal as deterministic JSON arrays.
+func TestMarshalJSON(t *testing.T) {
+	for _, tc := range []struct {
+		name string
+		in   any
+		want string
+	}{
+		{"strings", set.NewLookup("c", "a", "b"), `["a","b","c"]`},
+		{"ints in numeric order", set.NewLookup(10, 9, -1), `[-1,9,10]`},
+		{"custom string type", set.NewLookup[color]("red", "blue"), `["blue","red"]`},
+		{"custom int type", set.NewLookup[level](3, 1, 2), `[1,2,3]`},
+		{"bools", set.NewLookup(true, false), `[false,true]`},
+		{"structs by encoding", set.NewLookup(coord{2, 1}, coord{1, 2}), `[{"X":1,"Y":2},{"X":2,"Y":1}]`},
+		{"empty", set.NewLookup[string](), `[]`},
+		{"nil", set.Lookup[string](nil), `null`},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			// marshalling twice gives the same output
+			for range 2 {
+				data, err := json.Marshal(tc.in)
+				be.Require(t, err).To(be.Nil())
+				be.Expect(t, string(data)).To(be.Eq(tc.want))
+			}
+		})
+	}
+
+	t.Run("as a struct field", func(t *testing.T) {
+		cfg := struct {
+			Tags set.Lookup[string] `json:"tags"`
+		}{Tags: set.NewLookup("x", "y")}
+
+		data, err := json.Marshal(cfg)
+		be.Require(t, err).To(be.Nil())
+		be.Expect(t, string(data)).To(be.Eq(`{"tags":["x","y"]}`))
+	})
+}
+
+// TestUnmarshalJSON verifies lookups unmarshal from JSON arrays, collapsing duplicates.
+func TestUnmarshalJSON(t *testing.T) {
+	var cfg struct {
+		Tags   set.Lookup[string] `json:"tags"`
+		Levels set.Lookup[level]  `json:"levels"`
+		Points set.Lookup[coord]  `json:"points"`
+	}
+
+	err := json.Unmarshal([]byte(`{"tags":["a","b","a"],"levels":[1,2],"points":[{"X":1,"Y":1}]}`), &cfg)
+	be.Require(t, err).To(be.Nil())
+	be.Expect(t, cfg.Tags).To(be.Eq(set.NewLookup("a", "b")))
+	be.Expect(t, cfg.Levels).To(be.Eq(set.NewLookup[level](1, 2)))
+	be.Expect(t, cfg.Points).To(be.Eq(set.NewLookup(coord{1, 1})))
+
+	t.Run("replaces existing elements", func(t *testing.T) {
+		l := set.NewLookup("old")
+		be.Require(t, json.Unmarshal([]byte(`["new"]`), &l)).To(be.Nil())
+		be.Expect(t, l).To(be.Eq(set.NewLookup("new")))
+	})
+
+	t.Run("null", func(t *testing.T) {
+		l := set.NewLookup("old")
+		be.Require(t, json.Unmarshal([]byte(`null`), &l)).To(be.Nil())
+		be.Expect(t, l).To(be.Nil())
+	})
+
+	t.Run("invalid", func(t *testing.T) {
+		var l set.Lookup[int]
+		be.Expect(t, json.Unmarshal([]byte(`{"a":{}}`), &l)).To(be.HaveOccurred())
+		be.Expect(t, json.Unmarshal([]byte(`["a"]`), &l)).To(be.HaveOccurred())
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		orig := set.NewLookup(3, 1, 2)
+		data, err := json.Marshal(orig)
+		be.Require(t, err).To(be.Nil())
+
+		var got set.Lookup[int]
+		be.Require(t, json.Unmarshal(data, &got)).To(be.Nil())
+		be.Expect(t, got).To(be.Eq(orig))
+	})
+}
+
+// TestFromJSON verifies the duplicates option.
+func TestFromJSON(t *testing.T) {
+	l, err := set.FromJSON[string]([]byte(`["a","a"]`))
+	be.Require(t, err).To(be.Nil())
+	be.Expect(t, l).To(be.Eq(set.NewLookup("a")))
+
+	_, err = set.FromJSON[string]([]byte(`["a","b","a"]`), set.RejectDuplicates())
+	be.Expect(t, err).To(be.HaveOccurred())
+	be.Expect(t, err.Error()).To(be.Eq("duplicate element a"))
+
+	l, err = set.FromJSON[string]([]byte(`["a","b"]`), set.RejectDuplicates())
+	be.Require(t, err).To(be.Nil())
+	be.Expect(t, l).To(be.Eq(set.NewLookup("a", "b")))
+
+	l, err = set.FromJSON[string]([]byte(`null`))
+	be.Require(t, err).To(be.Nil())
+	be.Expect(t, l).To(be.Nil())
+}
+
+// TestText verifies comma-separated text marshalling.
+func TestText(t *testing.T) {
+	t.Run("marshal", func(t *testing.T) {
+		data, err := set.NewLookup("b", "c", "a").MarshalText()
+		be.Require(t, err).To(be.Nil())
+		be.Expect(t, string(data)).To(be.Eq("a,b,c"))
+
+		data, err = set.NewLookup(10, 2).MarshalText()
+		be.Require(t, err).To(be.Nil())
+		be.Expect(t, string(data)).To(be.Eq("2,10"))
+
+		data, err = set.NewLookup[string]().MarshalText()
+		be.Require(t, err).To(be.Nil())
+		be.Expect(t, string(data)).To(be.Eq(""))
+	})
+
+	t.Run("marshal rejects elements with commas", func(t *testing.T) {
+		_, err := set.NewLookup("a", "b,c").MarshalText()
+		be.Expect(t, err).To(be.HaveOccurred())
+
+		type point struct{ X, Y int }
+		_, err = set.NewLookup(point{1, 2}).MarshalText() // JSON text of structs has commas too
+		be.Expect(t, err).To(be.HaveOccurred())
+	})
+
+	t.Run("marshal rejects elements that wouldn't round-trip", func(t *testing.T) {
+		for _, elem := range []string{"", " a", "b ", "\tc"} {
+			_, err := set.NewLookup(elem, "x").MarshalText()
+			be.Expect(t, err).To(be.HaveOccurred())
+		}
+	})
+
+	t.Run("round trip", func(t *testing.T) {
+		want := set.NewLookup("a b", "c", "d-e")
+		data, err := want.MarshalText()
+		be.Require(t, err).To(be.Nil())
+
+		got, err := set.FromText[string](string(data))
+		be.Require(t, err).To(be.Nil())
+		be.Expect(t, got).To(be.Eq(want))
+	})
+
+	t.Run("unmarshal", func(t *testing.T) {
+		var tags set.Lookup[string]
+		be.Require(t, tags.UnmarshalText([]byte(" a, b ,,a,"))).To(be.Nil())
+		be.Expect(t, tags).To(be.Eq(set.NewLookup("a", "b")))
+
+		var ids set.Lookup[level]
+		be.Require(t, ids.UnmarshalText([]byte("1,2,3"))).To(be.Nil())
+		be.Expect(t, ids).To(be.Eq(set.NewLookup[level](1, 2, 3)))
+
+		var flags set.Lookup[bool]
+		be.Require(t, flags.UnmarshalText([]byte("true,false"))).To(be.Nil())
+		be.Expect(t, flags).To(be.Eq(set.NewLookup(true, false)))
+
+		var empty set.Lookup[string]
+		be.Require(t, empty.UnmarshalText([]byte(""))).To(be.Nil())
+		be.Expect(t, empty).To(be.Eq(set.NewLookup[string]()))
+
+		be.Expect(t, ids.UnmarshalText([]byte("1,two"))).To(be.HaveOccurred())
+	})
+
+	t.Run("reject duplicates", func(t *testing.T) {
+		_, err := set.FromText[string]("a,b,a", set.RejectDuplicates())
+		be.Expect(t, err).To(be.HaveOccurred())
+
+		l, err := set.FromText[int]("1, 2", set.RejectDuplicates())
+		be.Require(t, err).To(be.Nil())
+		be.Expect(t, l).To(be.Eq(set.NewLookup(1, 2)))
+	})
+
+	t.Run("flag.TextVar", func(t *testing.T) {
+		fs := flag.NewFlagSet("test", flag.ContinueOnError)
+		var tags set.Lookup[string]
+		fs.TextVar(&tags, "tags", set.NewLookup("default"), "tags to process")
+
+		be.Expect(t, tags).To(be.Eq(set.NewLookup("default")))
+		be.Expect(t, fs.Lookup("tags").DefValue).To(be.Eq("default"))
+
+		be.Require(t, fs.Parse([]string{"-tags", "x,y,x"})).To(be.Nil())
+		be.Expect(t, tags).To(be.Eq(set.NewLookup("x", "y")))
+	})
+}
+
+// TestYAML verifies lookups marshal as sorted YAML sequences and unmarshal from them.
+func TestYAML(t *testing.T) {
+	cfg := struct {
+		Tags set.Lookup[string] `yaml:"tags"`
+	}{Tags: set.NewLookup("b", "a")}
+
+	data, err := yaml.Marshal(cfg)
+	be.Require(t, err).To(be.Nil())
+	be.Expect(t, string(data)).To(be.Eq("tags:\n    - a\n    - b\n"))
+
+	var got struct {
+		Tags set.Lookup[string] `yaml:"tags"`
+		IDs  set.Lookup[int]    `yaml:"ids"`
+	}
+	err = yaml.Unmarshal([]byte("tags: [x, y, x]\nids:\n  - 1\n  - 2\n"), &got)
+	be.Require(t, err).To(be.Nil())
+	be.Expect(t, got.Tags).To(be.Eq(set.NewLookup("x", "y")))
+	be.Expect(t, got.IDs).To(be.Eq(set.NewLookup(1, 2)))
+
+	be.Expect(t, yaml.Unmarshal([]byte("ids: [a]"), &got)).To(be.HaveOccurred())
+}
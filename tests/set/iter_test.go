@@ -0,0 +1,81 @@
+package set_test
+
+import (
+	"cmp"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/amberpixels/k1/set"
+	"github.com/expectto/be"
+)
+
+// TestAll verifies All yields every element exactly once and respects early break.
+func TestAll(t *testing.T) {
+	l := set.NewLookup(3, 1, 2)
+
+	got := slices.Collect(l.All())
+	slices.Sort(got)
+	be.Expect(t, got).To(be.Eq([]int{1, 2, 3}))
+
+	n := 0
+	for range l.All() {
+		n++
+		break
+	}
+	be.Expect(t, n).To(be.Eq(1))
+
+	var empty set.Lookup[int]
+	be.Expect(t, slices.Collect(empty.All())).To(be.HaveLength(0))
+}
+
+// TestSlice verifies Slice returns a non-nil slice of all the elements.
+func TestSlice(t *testing.T) {
+	got := set.NewLookup("b", "a").Slice()
+	slices.Sort(got)
+	be.Expect(t, got).To(be.Eq([]string{"a", "b"}))
+
+	be.Expect(t, set.NewLookup[string]().Slice()).To(be.Eq([]string{}))
+}
+
+// TestSortedSlice verifies ordered elements are exported in ascending order.
+func TestSortedSlice(t *testing.T) {
+	be.Expect(t, set.SortedSlice(set.NewLookup(5, 3, 9, 1))).To(be.Eq([]int{1, 3, 5, 9}))
+	be.Expect(t, set.SortedSlice(set.NewLookup("pear", "apple"))).To(be.Eq([]string{"apple", "pear"}))
+
+	// composes with the set algebra
+	a, b := set.NewLookup(1, 2, 3), set.NewLookup(2, 3, 4)
+	be.Expect(t, set.SortedSlice(a.Intersection(b))).To(be.Eq([]int{2, 3}))
+}
+
+// TestSortedFunc verifies elements are exported in the comparator's order, for any comparable type.
+func TestSortedFunc(t *testing.T) {
+	type user struct {
+		ID   int
+		Name string
+	}
+
+	l := set.NewLookup(user{2, "bob"}, user{1, "alice"}, user{3, "carol"})
+	byID := func(a, b user) int { return cmp.Compare(a.ID, b.ID) }
+	be.Expect(t, l.SortedFunc(byID)).To(be.Eq([]user{{1, "alice"}, {2, "bob"}, {3, "carol"}}))
+
+	desc := set.NewLookup("a", "c", "b").SortedFunc(func(a, b string) int { return strings.Compare(b, a) })
+	be.Expect(t, desc).To(be.Eq([]string{"c", "b", "a"}))
+}
+
+// TestCollect verifies a lookup can be built from any iterator, e.g. a slices pipeline.
+func TestCollect(t *testing.T) {
+	l := set.Collect(slices.Values([]string{"a", "b", "a"}))
+	be.Expect(t, l).To(be.Eq(set.NewLookup("a", "b")))
+
+	// round trip through All
+	be.Expect(t, set.Collect(l.All())).To(be.Eq(l))
+
+	be.Expect(t, set.Collect(slices.Values([]int(nil)))).To(be.Eq(set.NewLookup[int]()))
+}
+
+// TestFromSlice verifies a lookup can be built from a slice, collapsing duplicates.
+func TestFromSlice(t *testing.T) {
+	be.Expect(t, set.FromSlice([]int{1, 2, 2, 3})).To(be.Eq(set.NewLookup(1, 2, 3)))
+	be.Expect(t, set.FromSlice([]int(nil))).To(be.Eq(set.NewLookup[int]()))
+}
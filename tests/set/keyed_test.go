@@ -0,0 +1,72 @@
+package set_test
+
+import (
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/amberpixels/k1/set"
+	"github.com/expectto/be"
+)
+
+// record is not comparable (it has a slice field), so it can't be put in a Lookup.
+type record struct {
+	ID   int
+	Tags []string
+}
+
+func recordID(r record) int { return r.ID }
+
+// TestKeyedLookup verifies values are deduplicated by key, keeping the first full record.
+func TestKeyedLookup(t *testing.T) {
+	kl := set.NewKeyedLookup(recordID,
+		record{ID: 1, Tags: []string{"first"}},
+		record{ID: 2},
+		record{ID: 1, Tags: []string{"second"}},
+	)
+	be.Expect(t, kl.Len()).To(be.Eq(2))
+
+	got, ok := kl.Get(1)
+	be.Expect(t, ok).To(be.True())
+	be.Expect(t, got.Tags).To(be.Eq([]string{"first"}))
+
+	_, ok = kl.Get(3)
+	be.Expect(t, ok).To(be.False())
+
+	be.Expect(t, kl.Has(record{ID: 2, Tags: []string{"whatever"}})).To(be.True())
+	be.Expect(t, kl.Has(record{ID: 3})).To(be.False())
+	be.Expect(t, kl.HasKey(1)).To(be.True())
+
+	kl.Add(record{ID: 3})
+	be.Expect(t, kl.Keys()).To(be.Eq(set.NewLookup(1, 2, 3)))
+
+	kl.Delete(record{ID: 1})
+	be.Expect(t, kl.HasKey(1)).To(be.False())
+
+	// once deleted, a new record for the key can be added
+	kl.Add(record{ID: 1, Tags: []string{"third"}})
+	got, _ = kl.Get(1)
+	be.Expect(t, got.Tags).To(be.Eq([]string{"third"}))
+
+	ids := slices.Sorted(func(yield func(int) bool) {
+		for r := range kl.All() {
+			if !yield(r.ID) {
+				return
+			}
+		}
+	})
+	be.Expect(t, ids).To(be.Eq([]int{1, 2, 3}))
+
+	kl.Clear()
+	be.Expect(t, kl.Len()).To(be.Eq(0))
+}
+
+// TestKeyedLookupByDerivedKey verifies comparable values can be deduplicated by a derived key too.
+func TestKeyedLookupByDerivedKey(t *testing.T) {
+	emails := set.NewKeyedLookup(strings.ToLower, "Alice@example.com", "bob@example.com", "ALICE@EXAMPLE.COM")
+	be.Expect(t, emails.Len()).To(be.Eq(2))
+
+	v, ok := emails.Get("alice@example.com")
+	be.Expect(t, ok).To(be.True())
+	be.Expect(t, v).To(be.Eq("Alice@example.com"))
+}
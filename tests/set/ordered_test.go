@@ -0,0 +1,258 @@
+package set_test
+
+import (
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/amberpixels/k1/set"
+	"github.com/expectto/be"
+)
+
+// TestOrderedZeroValue verifies the zero value of Ordered is ready to use.
+func TestOrderedZeroValue(t *testing.T) {
+	var o set.Ordered[string]
+	be.Expect(t, o.Len()).To(be.Eq(0))
+	be.Expect(t, o.Has("a")).To(be.False())
+
+	o.Add("a")
+	be.Expect(t, o.Has("a")).To(be.True())
+	be.Expect(t, o.Slice()).To(be.Eq([]string{"a"}))
+}
+
+// TestOrderedKeepsInsertionOrder verifies iteration follows insertion order and re-adding keeps the position.
+func TestOrderedKeepsInsertionOrder(t *testing.T) {
+	o := set.NewOrdered("c", "a", "b", "a")
+	be.Expect(t, o.Len()).To(be.Eq(3))
+	be.Expect(t, o.Slice()).To(be.Eq([]string{"c", "a", "b"}))
+
+	o.Add("c")
+	be.Expect(t, slices.Collect(o.All())).To(be.Eq([]string{"c", "a", "b"}))
+
+	// early break is respected
+	var first []string
+	for v := range o.All() {
+		first = append(first, v)
+		break
+	}
+	be.Expect(t, first).To(be.Eq([]string{"c"}))
+}
+
+// TestOrderedDelete verifies deletion keeps the relative order of the remaining elements.
+func TestOrderedDelete(t *testing.T) {
+	o := set.NewOrdered(1, 2, 3, 4, 5)
+
+	o.Delete(2)
+	o.Delete(42) // no-op
+	be.Expect(t, o.Has(2)).To(be.False())
+	be.Expect(t, o.Len()).To(be.Eq(4))
+	be.Expect(t, o.Slice()).To(be.Eq([]int{1, 3, 4, 5}))
+
+	// re-added elements go to the end
+	o.Add(2)
+	be.Expect(t, o.Slice()).To(be.Eq([]int{1, 3, 4, 5, 2}))
+
+	// deleting most elements triggers compaction, order must survive it
+	for _, v := range []int{1, 4, 5} {
+		o.Delete(v)
+	}
+	be.Expect(t, o.Slice()).To(be.Eq([]int{3, 2}))
+	be.Expect(t, o.Index(2)).To(be.Eq(1))
+}
+
+// TestOrderedIndexAndAt verifies positional access, including after deletions.
+func TestOrderedIndexAndAt(t *testing.T) {
+	o := set.NewOrdered("a", "b", "c", "d")
+
+	be.Expect(t, o.Index("a")).To(be.Eq(0))
+	be.Expect(t, o.Index("d")).To(be.Eq(3))
+	be.Expect(t, o.Index("z")).To(be.Eq(-1))
+	be.Expect(t, o.At(1)).To(be.Eq("b"))
+
+	o.Delete("b")
+	be.Expect(t, o.Index("c")).To(be.Eq(1))
+	be.Expect(t, o.At(1)).To(be.Eq("c"))
+	be.Expect(t, o.At(2)).To(be.Eq("d"))
+
+	be.Expect(t, func() { o.At(3) }).To(be.Panic())
+}
+
+// TestOrderedMixedDeleteAndPositions verifies Index and At against a slice model
+// while deletions, additions and positional reads are interleaved.
+func TestOrderedMixedDeleteAndPositions(t *testing.T) {
+	const n = 1000
+	o := set.NewOrdered[int]()
+	var model []int
+	for i := range n {
+		o.Add(i)
+		model = append(model, i)
+	}
+
+	for step := 0; len(model) > 0; step++ {
+		// delete from the middle, so tombstones pile up without being trimmed
+		v := o.At(len(model) / 2)
+		be.Require(t, v).To(be.Eq(model[len(model)/2]))
+		o.Delete(v)
+		model = slices.Delete(model, len(model)/2, len(model)/2+1)
+
+		if step%3 == 0 {
+			o.Add(n + step)
+			model = append(model, n+step)
+		}
+
+		for _, i := range []int{0, len(model) / 3, len(model) - 1} {
+			if i < 0 || i >= len(model) {
+				continue
+			}
+			be.Require(t, o.At(i)).To(be.Eq(model[i]))
+			be.Require(t, o.Index(model[i])).To(be.Eq(i))
+		}
+	}
+	be.Expect(t, o.Len()).To(be.Eq(0))
+}
+
+// TestOrderedConcurrentReads verifies Index and At don't write after deletions,
+// so concurrent reads are safe as they are for Lookup (run with -race).
+func TestOrderedConcurrentReads(t *testing.T) {
+	o := set.NewOrdered[int]()
+	for i := range 100 {
+		o.Add(i)
+	}
+	o.Delete(10)
+
+	var mismatches atomic.Int64
+	var wg sync.WaitGroup
+	for range 4 {
+		wg.Go(func() {
+			for i := range o.Len() {
+				if o.Index(o.At(i)) != i {
+					mismatches.Add(1)
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	be.Expect(t, mismatches.Load()).To(be.Eq(int64(0)))
+}
+
+// TestOrderedPop verifies Pop removes elements from the end, in LIFO order.
+func TestOrderedPop(t *testing.T) {
+	o := set.NewOrdered(1, 2, 3)
+	o.Delete(3)
+
+	v, ok := o.Pop()
+	be.Expect(t, ok).To(be.True())
+	be.Expect(t, v).To(be.Eq(2))
+
+	v, ok = o.Pop()
+	be.Expect(t, ok).To(be.True())
+	be.Expect(t, v).To(be.Eq(1))
+
+	v, ok = o.Pop()
+	be.Expect(t, ok).To(be.False())
+	be.Expect(t, v).To(be.Eq(0))
+	be.Expect(t, o.Len()).To(be.Eq(0))
+}
+
+// TestOrderedClear verifies Clear empties the set, which stays usable.
+func TestOrderedClear(t *testing.T) {
+	o := set.NewOrdered(1, 2, 3)
+	o.Delete(2)
+	o.Clear()
+
+	be.Expect(t, o.Len()).To(be.Eq(0))
+	be.Expect(t, o.Has(1)).To(be.False())
+	be.Expect(t, o.Slice()).To(be.Eq([]int{}))
+
+	o.Add(7)
+	be.Expect(t, o.Slice()).To(be.Eq([]int{7}))
+	be.Expect(t, o.Index(7)).To(be.Eq(0))
+}
+
+// TestOrderedAlgebra verifies set algebra results and their order.
+func TestOrderedAlgebra(t *testing.T) {
+	a := func() *set.Ordered[int] { return set.NewOrdered(4, 1, 3) }
+	b := func() *set.Ordered[int] { return set.NewOrdered(3, 5, 4, 6) }
+
+	be.Expect(t, a().Union(b()).Slice()).To(be.Eq([]int{4, 1, 3, 5, 6}))
+	be.Expect(t, a().Intersection(b()).Slice()).To(be.Eq([]int{4, 3}))
+	be.Expect(t, b().Intersection(a()).Slice()).To(be.Eq([]int{3, 4}))
+	be.Expect(t, a().Difference(b()).Slice()).To(be.Eq([]int{1}))
+	be.Expect(t, a().SymmetricDifference(b()).Slice()).To(be.Eq([]int{1, 5, 6}))
+
+	t.Run("allocating forms leave inputs untouched", func(t *testing.T) {
+		x, y := a(), b()
+		_ = x.Union(y)
+		_ = x.SymmetricDifference(y)
+		be.Expect(t, x.Slice()).To(be.Eq([]int{4, 1, 3}))
+		be.Expect(t, y.Slice()).To(be.Eq([]int{3, 5, 4, 6}))
+	})
+
+	t.Run("in place", func(t *testing.T) {
+		x := a()
+		x.UnionWith(b())
+		be.Expect(t, x.Slice()).To(be.Eq([]int{4, 1, 3, 5, 6}))
+
+		x = a()
+		x.IntersectionWith(b())
+		be.Expect(t, x.Slice()).To(be.Eq([]int{4, 3}))
+		be.Expect(t, x.Index(3)).To(be.Eq(1))
+
+		x = a()
+		x.DifferenceWith(b())
+		be.Expect(t, x.Slice()).To(be.Eq([]int{1}))
+
+		x = a()
+		x.SymmetricDifferenceWith(b())
+		be.Expect(t, x.Slice()).To(be.Eq([]int{1, 5, 6}))
+	})
+
+	t.Run("with itself", func(t *testing.T) {
+		x := a()
+		x.UnionWith(x)
+		be.Expect(t, x.Slice()).To(be.Eq([]int{4, 1, 3}))
+		x.IntersectionWith(x)
+		be.Expect(t, x.Slice()).To(be.Eq([]int{4, 1, 3}))
+		x.DifferenceWith(x)
+		be.Expect(t, x.Len()).To(be.Eq(0))
+	})
+}
+
+// TestOrderedPredicates verifies IsSubset, IsSuperset, IsDisjoint and Equal ignore the order.
+func TestOrderedPredicates(t *testing.T) {
+	small := set.NewOrdered("b", "a")
+	big := set.NewOrdered("a", "b", "c")
+	var empty set.Ordered[string]
+
+	be.Expect(t, small.IsSubset(big)).To(be.True())
+	be.Expect(t, big.IsSubset(small)).To(be.False())
+	be.Expect(t, empty.IsSubset(small)).To(be.True())
+	be.Expect(t, big.IsSuperset(small)).To(be.True())
+	be.Expect(t, small.IsSuperset(big)).To(be.False())
+
+	be.Expect(t, small.IsDisjoint(set.NewOrdered("x"))).To(be.True())
+	be.Expect(t, small.IsDisjoint(big)).To(be.False())
+
+	be.Expect(t, small.Equal(set.NewOrdered("a", "b"))).To(be.True())
+	be.Expect(t, small.Equal(big)).To(be.False())
+}
+
+// BenchmarkOrderedDrainByPosition drains the set via Delete(At(0)), which must not compact on every read.
+func BenchmarkOrderedDrainByPosition(b *testing.B) {
+	for _, size := range []int{1_000, 100_000} {
+		b.Run(strconv.Itoa(size), func(b *testing.B) {
+			for range b.N {
+				o := set.NewOrdered[int]()
+				for i := range size {
+					o.Add(i)
+				}
+				for o.Len() > 0 {
+					o.Delete(o.At(0))
+				}
+			}
+		})
+	}
+}
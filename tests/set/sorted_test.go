@@ -0,0 +1,208 @@
+package set_test
+
+import (
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/amberpixels/k1/set"
+	"github.com/expectto/be"
+)
+
+// TestSortedBasics verifies Has, Add, Delete, Len, Clear and ordered iteration, including the zero value.
+func TestSortedBasics(t *testing.T) {
+	var s set.Sorted[int]
+	be.Expect(t, s.Len()).To(be.Eq(0))
+	be.Expect(t, s.Slice()).To(be.Eq([]int{}))
+
+	for _, v := range []int{5, 1, 9, 3, 7, 3} {
+		s.Add(v)
+	}
+	be.Expect(t, s.Len()).To(be.Eq(5))
+	be.Expect(t, s.Has(3)).To(be.True())
+	be.Expect(t, s.Has(4)).To(be.False())
+	be.Expect(t, slices.Collect(s.All())).To(be.Eq([]int{1, 3, 5, 7, 9}))
+
+	s.Delete(5)
+	s.Delete(42) // no-op
+	be.Expect(t, s.Len()).To(be.Eq(4))
+	be.Expect(t, s.Slice()).To(be.Eq([]int{1, 3, 7, 9}))
+
+	var first []int
+	for v := range s.All() {
+		first = append(first, v)
+		if len(first) == 2 {
+			break
+		}
+	}
+	be.Expect(t, first).To(be.Eq([]int{1, 3}))
+
+	s.Clear()
+	be.Expect(t, s.Len()).To(be.Eq(0))
+	be.Expect(t, s.Has(1)).To(be.False())
+}
+
+// TestSortedQueries verifies Min, Max, Floor, Ceiling and Range.
+func TestSortedQueries(t *testing.T) {
+	s := set.NewSorted(10, 20, 30, 40)
+
+	minV, ok := s.Min()
+	be.Expect(t, ok).To(be.True())
+	be.Expect(t, minV).To(be.Eq(10))
+	maxV, ok := s.Max()
+	be.Expect(t, ok).To(be.True())
+	be.Expect(t, maxV).To(be.Eq(40))
+
+	for _, tc := range []struct {
+		v              int
+		floor, ceiling int
+		hasF, hasC     bool
+	}{
+		{5, 0, 10, false, true},
+		{10, 10, 10, true, true},
+		{25, 20, 30, true, true},
+		{40, 40, 40, true, true},
+		{45, 40, 0, true, false},
+	} {
+		f, ok := s.Floor(tc.v)
+		be.Expect(t, ok).To(be.Eq(tc.hasF))
+		be.Expect(t, f).To(be.Eq(tc.floor))
+
+		c, ok := s.Ceiling(tc.v)
+		be.Expect(t, ok).To(be.Eq(tc.hasC))
+		be.Expect(t, c).To(be.Eq(tc.ceiling))
+	}
+
+	be.Expect(t, slices.Collect(s.Range(20, 40))).To(be.Eq([]int{20, 30}))
+	be.Expect(t, slices.Collect(s.Range(15, 100))).To(be.Eq([]int{20, 30, 40}))
+	be.Expect(t, slices.Collect(s.Range(31, 39))).To(be.HaveLength(0))
+	be.Expect(t, slices.Collect(s.Range(40, 10))).To(be.HaveLength(0))
+
+	var empty set.Sorted[int]
+	_, ok = empty.Min()
+	be.Expect(t, ok).To(be.False())
+	_, ok = empty.Max()
+	be.Expect(t, ok).To(be.False())
+	_, ok = empty.Floor(1)
+	be.Expect(t, ok).To(be.False())
+}
+
+// TestSortedTimestamps verifies sorted sets of time-like values, e.g. finding the latest event before a moment.
+func TestSortedTimestamps(t *testing.T) {
+	base := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	events := set.NewSorted(base.Unix(), base.Add(time.Hour).Unix(), base.Add(3*time.Hour).Unix())
+
+	latest, ok := events.Floor(base.Add(2 * time.Hour).Unix())
+	be.Expect(t, ok).To(be.True())
+	be.Expect(t, latest).To(be.Eq(base.Add(time.Hour).Unix()))
+}
+
+// TestSortedAlgebra verifies set algebra results stay sorted.
+func TestSortedAlgebra(t *testing.T) {
+	a := func() *set.Sorted[string] { return set.NewSorted("d", "a", "c") }
+	b := func() *set.Sorted[string] { return set.NewSorted("c", "e", "b") }
+
+	be.Expect(t, a().Union(b()).Slice()).To(be.Eq([]string{"a", "b", "c", "d", "e"}))
+	be.Expect(t, a().Intersection(b()).Slice()).To(be.Eq([]string{"c"}))
+	be.Expect(t, a().Difference(b()).Slice()).To(be.Eq([]string{"a", "d"}))
+	be.Expect(t, a().SymmetricDifference(b()).Slice()).To(be.Eq([]string{"a", "b", "d", "e"}))
+
+	x := a()
+	x.UnionWith(b())
+	be.Expect(t, x.Len()).To(be.Eq(5))
+	x.DifferenceWith(a())
+	be.Expect(t, x.Slice()).To(be.Eq([]string{"b", "e"}))
+	x.IntersectionWith(b())
+	be.Expect(t, x.Slice()).To(be.Eq([]string{"b", "e"}))
+	x.SymmetricDifferenceWith(b())
+	be.Expect(t, x.Slice()).To(be.Eq([]string{"c"}))
+
+	// results stay fully functional trees
+	x.Add("a")
+	x.Delete("c")
+	be.Expect(t, x.Slice()).To(be.Eq([]string{"a"}))
+
+	be.Expect(t, set.NewSorted("a").IsSubset(a())).To(be.True())
+	be.Expect(t, a().IsSuperset(set.NewSorted("a", "z"))).To(be.False())
+	be.Expect(t, a().IsDisjoint(set.NewSorted("x", "y"))).To(be.True())
+	be.Expect(t, a().Equal(set.NewSorted("c", "d", "a"))).To(be.True())
+	be.Expect(t, a().Equal(b())).To(be.False())
+}
+
+// FuzzSorted checks Sorted against a naive reference (a Lookup plus sorting) on random operation sequences.
+// Every pair of bytes is an operation: the first byte selects the operation, the second is the element.
+func FuzzSorted(f *testing.F) {
+	f.Add([]byte{0, 5, 0, 3, 0, 8, 1, 3, 0, 1})
+	f.Add([]byte{0, 1, 0, 2, 0, 3, 0, 4, 0, 5, 0, 6, 0, 7, 1, 4, 1, 1, 1, 7})
+	f.Add([]byte{2, 10, 2, 20, 3, 10, 0, 200, 1, 200, 0, 128})
+
+	f.Fuzz(func(t *testing.T, ops []byte) {
+		a, b := set.NewSorted[int8](), set.NewSorted[int8]()
+		refA, refB := set.NewLookup[int8](), set.NewLookup[int8]()
+
+		for i := 0; i+1 < len(ops); i += 2 {
+			v := int8(ops[i+1])
+			switch ops[i] % 4 {
+			case 0:
+				a.Add(v)
+				refA.Add(v)
+			case 1:
+				a.Delete(v)
+				refA.Delete(v)
+			case 2:
+				b.Add(v)
+				refB.Add(v)
+			default:
+				b.Delete(v)
+				refB.Delete(v)
+			}
+			be.Require(t, a.Len()).To(be.Eq(len(refA)))
+			be.Require(t, a.Has(v)).To(be.Eq(refA.Has(v)))
+		}
+
+		want := set.SortedSlice(refA)
+		be.Require(t, a.Slice()).To(be.Eq(want))
+		be.Require(t, b.Slice()).To(be.Eq(set.SortedSlice(refB)))
+
+		for q := -128; q < 128; q += 7 {
+			v := int8(q)
+
+			var floor, ceiling []int8
+			for _, w := range want {
+				if w <= v {
+					floor = append(floor, w)
+				}
+				if w >= v && len(ceiling) == 0 {
+					ceiling = append(ceiling, w)
+				}
+			}
+			f, ok := a.Floor(v)
+			be.Require(t, ok).To(be.Eq(len(floor) > 0))
+			if ok {
+				be.Require(t, f).To(be.Eq(floor[len(floor)-1]))
+			}
+			c, ok := a.Ceiling(v)
+			be.Require(t, ok).To(be.Eq(len(ceiling) > 0))
+			if ok {
+				be.Require(t, c).To(be.Eq(ceiling[0]))
+			}
+
+			hi := int8(min(q+20, 127))
+			var inRange []int8
+			for _, w := range want {
+				if w >= v && w < hi {
+					inRange = append(inRange, w)
+				}
+			}
+			be.Require(t, slices.Collect(a.Range(v, hi))).To(be.Eq(inRange))
+		}
+
+		be.Require(t, a.Union(b).Slice()).To(be.Eq(set.SortedSlice(refA.Union(refB))))
+		be.Require(t, a.Intersection(b).Slice()).To(be.Eq(set.SortedSlice(refA.Intersection(refB))))
+		be.Require(t, a.Difference(b).Slice()).To(be.Eq(set.SortedSlice(refA.Difference(refB))))
+		be.Require(t, a.SymmetricDifference(b).Slice()).To(be.Eq(set.SortedSlice(refA.SymmetricDifference(refB))))
+		be.Require(t, a.IsSubset(b)).To(be.Eq(refA.IsSubset(refB)))
+		be.Require(t, a.IsDisjoint(b)).To(be.Eq(refA.IsDisjoint(refB)))
+		be.Require(t, a.Equal(b)).To(be.Eq(refA.Equal(refB)))
+	})
+}
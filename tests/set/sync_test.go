@@ -0,0 +1,188 @@
+package set_test
+
+import (
+	"slices"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"testing"
+
+	"github.com/amberpixels/k1/set"
+	"github.com/expectto/be"
+)
+
+// TestSyncBasics verifies the single-goroutine behaviour of Sync, including its zero value.
+func TestSyncBasics(t *testing.T) {
+	var s set.Sync[string]
+	be.Expect(t, s.Len()).To(be.Eq(0))
+	be.Expect(t, s.Has("a")).To(be.False())
+
+	s.Add("a")
+	be.Expect(t, s.Has("a")).To(be.True())
+	be.Expect(t, s.AddIfAbsent("a")).To(be.False())
+	be.Expect(t, s.AddIfAbsent("b")).To(be.True())
+	be.Expect(t, s.Len()).To(be.Eq(2))
+
+	be.Expect(t, s.LoadOrStore("b")).To(be.True())
+	be.Expect(t, s.LoadOrStore("c")).To(be.False())
+	be.Expect(t, s.Has("c")).To(be.True())
+
+	be.Expect(t, s.LoadAndDelete("c")).To(be.True())
+	be.Expect(t, s.LoadAndDelete("c")).To(be.False())
+
+	s.Delete("b")
+	be.Expect(t, s.Snapshot()).To(be.Eq(set.NewLookup("a")))
+
+	s.Clear()
+	be.Expect(t, s.Len()).To(be.Eq(0))
+}
+
+// TestSyncSnapshot verifies snapshots are detached copies and All can modify the set while iterating.
+func TestSyncSnapshot(t *testing.T) {
+	s := set.NewSync(1, 2, 3)
+
+	snap := s.Snapshot()
+	snap.Add(100)
+	be.Expect(t, s.Has(100)).To(be.False())
+
+	s.Add(4)
+	be.Expect(t, snap.Has(4)).To(be.False())
+
+	// deleting from within the loop must not deadlock
+	n := 0
+	for v := range s.All() {
+		s.Delete(v)
+		n++
+	}
+	be.Expect(t, n).To(be.Eq(4))
+	be.Expect(t, s.Len()).To(be.Eq(0))
+}
+
+// TestSyncConcurrentAddIfAbsent verifies that exactly one goroutine wins each element.
+// Run with -race to verify the locking.
+func TestSyncConcurrentAddIfAbsent(t *testing.T) {
+	const (
+		workers = 8
+		keys    = 1000
+	)
+
+	s := set.NewSync[int]()
+	var added atomic.Int64
+
+	var wg sync.WaitGroup
+	for range workers {
+		wg.Go(func() {
+			for k := range keys {
+				if s.AddIfAbsent(k) {
+					added.Add(1)
+				}
+				_ = s.Has(k)
+				_ = s.Len()
+			}
+		})
+	}
+	wg.Wait()
+
+	be.Expect(t, added.Load()).To(be.Eq(int64(keys)))
+	be.Expect(t, s.Len()).To(be.Eq(keys))
+}
+
+// TestSyncConcurrentMixed hammers every method from several goroutines at once. Run with -race.
+func TestSyncConcurrentMixed(t *testing.T) {
+	s := set.NewSync[string]()
+
+	var wg sync.WaitGroup
+	for w := range 4 {
+		wg.Go(func() {
+			for i := range 200 {
+				k := strconv.Itoa(i % 50)
+				switch (w + i) % 5 {
+				case 0:
+					s.Add(k)
+				case 1:
+					s.LoadAndDelete(k)
+				case 2:
+					_ = slices.Collect(s.All())
+				case 3:
+					_ = s.Snapshot().Has(k)
+				default:
+					s.LoadOrStore(k)
+				}
+			}
+		})
+	}
+	wg.Wait()
+
+	be.Expect(t, s.Len()).To(be.Eq(len(s.Snapshot())))
+}
+
+// BenchmarkSyncVsSyncMap compares Sync with sync.Map used as a set, for read-heavy and write-heavy loads.
+func BenchmarkSyncVsSyncMap(b *testing.B) {
+	const keys = 1 << 10
+
+	b.Run("Sync/read-heavy", func(b *testing.B) {
+		s := set.NewSync[int]()
+		for k := range keys {
+			s.Add(k)
+		}
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				if i%10 == 0 {
+					s.AddIfAbsent(i % (2 * keys))
+				} else {
+					_ = s.Has(i % keys)
+				}
+				i++
+			}
+		})
+	})
+
+	b.Run("sync.Map/read-heavy", func(b *testing.B) {
+		var m sync.Map
+		for k := range keys {
+			m.Store(k, struct{}{})
+		}
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				if i%10 == 0 {
+					m.LoadOrStore(i%(2*keys), struct{}{})
+				} else {
+					_, _ = m.Load(i % keys)
+				}
+				i++
+			}
+		})
+	})
+
+	b.Run("Sync/write-heavy", func(b *testing.B) {
+		s := set.NewSync[int]()
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				if i%2 == 0 {
+					s.AddIfAbsent(i % keys)
+				} else {
+					s.LoadAndDelete(i % keys)
+				}
+				i++
+			}
+		})
+	})
+
+	b.Run("sync.Map/write-heavy", func(b *testing.B) {
+		var m sync.Map
+		b.RunParallel(func(pb *testing.PB) {
+			i := 0
+			for pb.Next() {
+				if i%2 == 0 {
+					m.LoadOrStore(i%keys, struct{}{})
+				} else {
+					m.LoadAndDelete(i % keys)
+				}
+				i++
+			}
+		})
+	})
+}